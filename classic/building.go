@@ -0,0 +1,149 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Buildings returns a list of buildings available in Jamf
+func (j *Client) Buildings() ([]Building, error) {
+	return j.BuildingsContext(context.Background())
+}
+
+// BuildingsContext is like Buildings but uses the given context for the request
+func (j *Client) BuildingsContext(ctx context.Context) ([]Building, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, buildingsContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf buildings query request")
+	}
+	res := Buildings{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available buildings from %s", ep)
+	}
+	return res.List, nil
+}
+
+// BuildingDetails returns the details for a specific building given its ID or Name
+func (j *Client) BuildingDetails(identifier any) (*BuildingDetails, error) {
+	return j.BuildingDetailsContext(context.Background(), identifier)
+}
+
+// BuildingDetailsContext is like BuildingDetails but uses the given context for the request
+func (j *Client) BuildingDetailsContext(ctx context.Context, identifier any) (*BuildingDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, buildingsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for building: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for building: %v", identifier)
+	}
+
+	res := buildingResponse{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query building: %v from %s", identifier, ep)
+	}
+	return &res.Details, nil
+}
+
+// UpdateBuilding will update a building in Jamf by either ID or Name
+func (j *Client) UpdateBuilding(identifier any, building *BuildingDetails) (*BuildingDetails, error) {
+	return j.UpdateBuildingContext(context.Background(), identifier, building)
+}
+
+// UpdateBuildingContext is like UpdateBuilding but uses the given context for the request
+func (j *Client) UpdateBuildingContext(ctx context.Context, identifier any, building *BuildingDetails) (*BuildingDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, buildingsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for building: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(building)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for building: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for building: %v (%s)", identifier, ep)
+	}
+
+	res := BuildingDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for building: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateBuilding will create a building in Jamf
+func (j *Client) CreateBuilding(building *BuildingDetails) (*BuildingDetails, error) {
+	return j.CreateBuildingContext(context.Background(), building)
+}
+
+// CreateBuildingContext is like CreateBuilding but uses the given context for the request
+func (j *Client) CreateBuildingContext(ctx context.Context, building *BuildingDetails) (*BuildingDetails, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, buildingsContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF query request for new building")
+	}
+
+	if building.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new building"), "unable to process JAMF creation request for building: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(building)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for building: %v", building.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for building: %v (%s)", building.Name, ep)
+	}
+
+	res := BuildingDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for building: %v (%s)", building.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteBuilding will delete a building by either ID or Name
+func (j *Client) DeleteBuilding(identifier any) (*BuildingDetails, error) {
+	return j.DeleteBuildingContext(context.Background(), identifier)
+}
+
+// DeleteBuildingContext is like DeleteBuilding but uses the given context for the request
+func (j *Client) DeleteBuildingContext(ctx context.Context, identifier any) (*BuildingDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, buildingsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for building: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for building: %v (%s)", identifier, ep)
+	}
+
+	res := BuildingDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for building: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,36 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "encoding/xml"
+
+// Buildings represents a list of buildings in Jamf
+type Buildings struct {
+	List []Building `json:"buildings" xml:"building,omitempty"`
+	Size int        `json:"size" xml:"size"`
+}
+
+// BuildingDetails holds the details of a building configured in Jamf including its address
+type BuildingDetails struct {
+	XMLName        xml.Name `json:"-" xml:"building,omitempty"`
+	ID             int      `json:"id,omitempty" xml:"id,omitempty"`
+	Name           string   `json:"name" xml:"name,omitempty"`
+	StreetAddress1 string   `json:"streetAddress1" xml:"streetAddress1,omitempty"`
+	StreetAddress2 string   `json:"streetAddress2" xml:"streetAddress2,omitempty"`
+	City           string   `json:"city" xml:"city,omitempty"`
+	StateProvince  string   `json:"stateProvince" xml:"stateProvince,omitempty"`
+	ZipPostalCode  string   `json:"zipPostalCode" xml:"zipPostalCode,omitempty"`
+	Country        string   `json:"country" xml:"country,omitempty"`
+}
+
+// buildingResponse unwraps the building returned by the classic API
+type buildingResponse struct {
+	Details BuildingDetails `json:"building"`
+}
+
+// UnmarshalXML decodes the building element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (b *buildingResponse) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&b.Details, &start)
+}
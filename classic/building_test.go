@@ -0,0 +1,129 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var BUILDINGS_BASE_API_ENDPOINT = "/JSSResource/buildings"
+
+func buildingsResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case BUILDINGS_BASE_API_ENDPOINT:
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<buildings>
+					<size>2</size>
+					<building><id>1</id><name>Boston</name></building>
+					<building><id>2</id><name>New York</name></building>
+				</buildings>`)
+		case fmt.Sprintf("%s/id/1", BUILDINGS_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{
+					"building": {
+						"id": 1,
+						"name": "Boston",
+						"streetAddress1": "100 Summer St",
+						"streetAddress2": "Floor 12",
+						"city": "Boston",
+						"stateProvince": "MA",
+						"zipPostalCode": "02110",
+						"country": "United States"
+					}
+				}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				building := &jamf.BuildingDetails{}
+				assert.Nil(t, xml.Unmarshal(data, building))
+				assert.Equal(t, "200 Summer St", building.StreetAddress1)
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><building><id>1</id></building>`)
+			case "DELETE":
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><building><id>1</id></building>`)
+			}
+		case fmt.Sprintf("%s/name/New%%20York", BUILDINGS_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<building>
+					<id>2</id>
+					<name>New York</name>
+					<streetAddress1>1 World Trade Center</streetAddress1>
+					<city>New York</city>
+					<stateProvince>NY</stateProvince>
+					<zipPostalCode>10007</zipPostalCode>
+					<country>United States</country>
+				</building>`)
+		case fmt.Sprintf("%s/id/-1", BUILDINGS_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, `<building><name>Paris</name><city>Paris</city><country>France</country></building>`, string(data))
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><building><id>3</id></building>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf buildings API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestBuildings(t *testing.T) {
+	testServer := buildingsResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	buildings, err := j.Buildings()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(buildings))
+	assert.Equal(t, 1, buildings[0].ID)
+	assert.Equal(t, "New York", buildings[1].Name)
+
+	building, err := j.BuildingDetails(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "Boston", building.Name)
+	assert.Equal(t, "100 Summer St", building.StreetAddress1)
+	assert.Equal(t, "Floor 12", building.StreetAddress2)
+	assert.Equal(t, "MA", building.StateProvince)
+	assert.Equal(t, "02110", building.ZipPostalCode)
+
+	building, err = j.BuildingDetails("New York")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, building.ID)
+	assert.Equal(t, "1 World Trade Center", building.StreetAddress1)
+	assert.Equal(t, "United States", building.Country)
+}
+
+func TestCreateUpdateDeleteBuilding(t *testing.T) {
+	testServer := buildingsResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	_, err = j.CreateBuilding(&jamf.BuildingDetails{City: "Paris"})
+	assert.NotNil(t, err)
+
+	building, err := j.CreateBuilding(&jamf.BuildingDetails{Name: "Paris", City: "Paris", Country: "France"})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, building.ID)
+
+	building, err = j.UpdateBuilding(1, &jamf.BuildingDetails{StreetAddress1: "200 Summer St"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, building.ID)
+
+	building, err = j.DeleteBuilding(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, building.ID)
+}
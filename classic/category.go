@@ -0,0 +1,199 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Categories returns a list of categories available in Jamf
+func (j *Client) Categories() ([]BasicCategoryInfo, error) {
+	return j.CategoriesContext(context.Background())
+}
+
+// CategoriesContext is like Categories but uses the given context for the request
+func (j *Client) CategoriesContext(ctx context.Context) ([]BasicCategoryInfo, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, categoriesContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf categories query request")
+	}
+	res := Categories{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available categories from %s", ep)
+	}
+	return res.List, nil
+}
+
+// CategoryDetails returns the details for a specific category given its ID or Name
+func (j *Client) CategoryDetails(identifier any) (*Category, error) {
+	return j.CategoryDetailsContext(context.Background(), identifier)
+}
+
+// CategoryDetailsContext is like CategoryDetails but uses the given context for the request
+func (j *Client) CategoryDetailsContext(ctx context.Context, identifier any) (*Category, error) {
+	ep, err := EndpointBuilder(j.Endpoint, categoriesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for category: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for category: %v", identifier)
+	}
+
+	res := Category{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query category: %v from %s", identifier, ep)
+	}
+	return &res, nil
+}
+
+// UpdateCategory will update a category in Jamf by either ID or Name
+func (j *Client) UpdateCategory(identifier any, category *CategoryDetails) (*CategoryDetails, error) {
+	return j.UpdateCategoryContext(context.Background(), identifier, category)
+}
+
+// UpdateCategoryContext is like UpdateCategory but uses the given context for the request
+func (j *Client) UpdateCategoryContext(ctx context.Context, identifier any, category *CategoryDetails) (*CategoryDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, categoriesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for category: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(category)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for category: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for category: %v (%s)", identifier, ep)
+	}
+
+	res := CategoryDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for category: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateCategory will create a category in Jamf
+func (j *Client) CreateCategory(category *CategoryDetails) (*CategoryDetails, error) {
+	return j.CreateCategoryContext(context.Background(), category)
+}
+
+// CreateCategoryContext is like CreateCategory but uses the given context for the request
+func (j *Client) CreateCategoryContext(ctx context.Context, category *CategoryDetails) (*CategoryDetails, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, categoriesContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF query request for new category")
+	}
+
+	if category.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new category"), "unable to process JAMF creation request for category: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(category)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for category: %v", category.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for category: %v (%s)", category.Name, ep)
+	}
+
+	res := CategoryDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for category: %v (%s)", category.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteCategory will delete a category by either ID or Name
+func (j *Client) DeleteCategory(identifier any) (*CategoryDetails, error) {
+	return j.DeleteCategoryContext(context.Background(), identifier)
+}
+
+// DeleteCategoryContext is like DeleteCategory but uses the given context for the request
+func (j *Client) DeleteCategoryContext(ctx context.Context, identifier any) (*CategoryDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, categoriesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for category: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for category: %v (%s)", identifier, ep)
+	}
+
+	res := CategoryDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for category: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// ResolveCategoryID returns the ID of the category with the given name, creating the category when it doesn't exist
+func (j *Client) ResolveCategoryID(name string) (int, error) {
+	return j.ResolveCategoryIDContext(context.Background(), name)
+}
+
+// ResolveCategoryIDContext is like ResolveCategoryID but uses the given context for the requests
+func (j *Client) ResolveCategoryIDContext(ctx context.Context, name string) (int, error) {
+	if name == "" {
+		return 0, errors.New("unable to resolve category: name is required")
+	}
+
+	category, err := j.CategoryDetailsContext(ctx, name)
+	if err == nil {
+		return category.Details.ID, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return 0, errors.Wrapf(err, "unable to resolve category: %s", name)
+	}
+
+	created, err := j.CreateCategoryContext(ctx, &CategoryDetails{Name: name})
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to resolve category: %s", name)
+	}
+	return created.ID, nil
+}
+
+// validateCategory returns a *CategoryNotFoundError when category validation is enabled using
+// WithCategoryValidation and the referenced category doesn't exist in Jamf
+func (j *Client) validateCategory(ctx context.Context, identifier any) error {
+	if !j.validateCategories {
+		return nil
+	}
+	switch id := identifier.(type) {
+	case string:
+		if id == "" || id == NoCategory {
+			return nil
+		}
+	case int:
+		if id <= 0 {
+			return nil
+		}
+	}
+
+	_, err := j.CategoryDetailsContext(ctx, identifier)
+	if errors.Is(err, ErrNotFound) {
+		return &CategoryNotFoundError{Category: fmt.Sprint(identifier)}
+	}
+	return err
+}
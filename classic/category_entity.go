@@ -0,0 +1,41 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "encoding/xml"
+
+// NoCategory is the category Jamf reports for resources which aren't assigned a category
+const NoCategory = "No category assigned"
+
+// Categories represents a list of categories in Jamf
+type Categories struct {
+	List []BasicCategoryInfo `json:"categories" xml:"category,omitempty"`
+	Size int                 `json:"size" xml:"size"`
+}
+
+// BasicCategoryInfo represents the information returned in a list of all categories from Jamf
+type BasicCategoryInfo struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name"`
+}
+
+// Category represents a single category in Jamf
+type Category struct {
+	Details CategoryDetails `json:"category" xml:"category,omitempty"`
+}
+
+// UnmarshalXML decodes the category element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (c *Category) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&c.Details, &start)
+}
+
+// CategoryDetails holds the details of a category used to group policies, scripts, packages
+// and configuration profiles in Jamf
+type CategoryDetails struct {
+	XMLName  xml.Name `json:"-" xml:"category,omitempty"`
+	ID       int      `json:"id,omitempty" xml:"id,omitempty"`
+	Name     string   `json:"name" xml:"name,omitempty"`
+	Priority int      `json:"priority,omitempty" xml:"priority,omitempty"`
+}
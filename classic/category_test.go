@@ -0,0 +1,205 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var CATEGORIES_BASE_API_ENDPOINT = "/JSSResource/categories"
+
+func categoriesResponseMocks(t *testing.T, created *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case CATEGORIES_BASE_API_ENDPOINT:
+			fmt.Fprint(w, `{
+				"categories": [
+					{
+						"id": 1,
+						"name": "Productivity"
+					},
+					{
+						"id": 2,
+						"name": "Security"
+					}]
+				}`)
+		case fmt.Sprintf("%s/id/1", CATEGORIES_BASE_API_ENDPOINT), fmt.Sprintf("%s/name/Productivity", CATEGORIES_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{"category": {"id": 1, "name": "Productivity", "priority": 9}}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				assert.Equal(t, `<category><name>Apps</name><priority>5</priority></category>`, string(data))
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><category><id>1</id></category>`)
+			case "DELETE":
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><category><id>1</id></category>`)
+			}
+		case fmt.Sprintf("%s/name/Security", CATEGORIES_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><category><id>2</id><name>Security</name><priority>1</priority></category>`)
+		case fmt.Sprintf("%s/name/A%%2FB", CATEGORIES_BASE_API_ENDPOINT):
+			fmt.Fprint(w, `{"category": {"id": 5, "name": "A/B", "priority": 9}}`)
+		case fmt.Sprintf("%s/name/Dev%%20%%231%%2FTools", CATEGORIES_BASE_API_ENDPOINT):
+			fmt.Fprint(w, `{"category": {"id": 4, "name": "Dev #1/Tools", "priority": 9}}`)
+		case fmt.Sprintf("%s/name/Monitoring", CATEGORIES_BASE_API_ENDPOINT), fmt.Sprintf("%s/name/QA%%20%%232%%2FTools", CATEGORIES_BASE_API_ENDPOINT), fmt.Sprintf("%s/id/404", CATEGORIES_BASE_API_ENDPOINT):
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<html><body><p>Not Found</p><p>The server has not found anything matching the request URI</p></body></html>`)
+		case fmt.Sprintf("%s/id/-1", CATEGORIES_BASE_API_ENDPOINT):
+			atomic.AddInt32(created, 1)
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, `<category><name>Monitoring</name></category>`, string(data))
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><category><id>3</id></category>`)
+		case "/JSSResource/policies/id/-1":
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><policy><id>10</id></policy>`)
+		case "/JSSResource/scripts/id/-1":
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><script><id>11</id></script>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf categories API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestCategories(t *testing.T) {
+	var created int32
+	testServer := categoriesResponseMocks(t, &created)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	categories, err := j.Categories()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(categories))
+	assert.Equal(t, "Security", categories[1].Name)
+
+	category, err := j.CategoryDetails(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "Productivity", category.Details.Name)
+	assert.Equal(t, 9, category.Details.Priority)
+
+	category, err = j.CategoryDetails("Security")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, category.Details.ID)
+	assert.Equal(t, 1, category.Details.Priority)
+
+	// names are escaped so / is not treated as part of the URL
+	category, err = j.CategoryDetails("A/B")
+	assert.Nil(t, err)
+	assert.Equal(t, 5, category.Details.ID)
+
+	_, err = j.CategoryDetails(404)
+	assert.True(t, errors.Is(err, jamf.ErrNotFound))
+}
+
+func TestCreateUpdateDeleteCategory(t *testing.T) {
+	var created int32
+	testServer := categoriesResponseMocks(t, &created)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	_, err = j.CreateCategory(&jamf.CategoryDetails{})
+	assert.NotNil(t, err)
+
+	category, err := j.CreateCategory(&jamf.CategoryDetails{Name: "Monitoring"})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, category.ID)
+
+	category, err = j.UpdateCategory("Productivity", &jamf.CategoryDetails{Name: "Apps", Priority: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, category.ID)
+
+	category, err = j.DeleteCategory(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, category.ID)
+}
+
+func TestResolveCategoryID(t *testing.T) {
+	var created int32
+	testServer := categoriesResponseMocks(t, &created)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	id, err := j.ResolveCategoryID("Productivity")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, id)
+	assert.Equal(t, int32(0), created)
+
+	// missing categories are created
+	id, err = j.ResolveCategoryID("Monitoring")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, id)
+	assert.Equal(t, int32(1), created)
+
+	// names are escaped so # and / are not treated as part of the URL
+	id, err = j.ResolveCategoryID("Dev #1/Tools")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, id)
+	assert.Equal(t, int32(1), created)
+
+	_, err = j.ResolveCategoryID("")
+	assert.NotNil(t, err)
+}
+
+func TestCategoryValidation(t *testing.T) {
+	var created int32
+	testServer := categoriesResponseMocks(t, &created)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithCategoryValidation())
+	assert.Nil(t, err)
+
+	_, err = j.CreatePolicy(&jamf.PolicyContents{
+		General: &jamf.PolicyGeneral{Name: "Install Zoom", Category: &jamf.PolicyCategory{Name: "Productivity"}},
+	})
+	assert.Nil(t, err)
+
+	_, err = j.CreatePolicy(&jamf.PolicyContents{
+		General: &jamf.PolicyGeneral{Name: "Install Agent", Category: &jamf.PolicyCategory{Name: "Monitoring"}},
+	})
+	assert.True(t, errors.Is(err, jamf.ErrCategoryNotFound))
+	var notFound *jamf.CategoryNotFoundError
+	assert.True(t, errors.As(err, &notFound))
+	assert.Equal(t, "Monitoring", notFound.Category)
+
+	_, err = j.CreatePolicy(&jamf.PolicyContents{
+		General: &jamf.PolicyGeneral{Name: "Install Agent", Category: &jamf.PolicyCategory{ID: 404}},
+	})
+	assert.True(t, errors.Is(err, jamf.ErrCategoryNotFound))
+
+	script, err := j.CreateScript(&jamf.ScriptContents{Name: "enable-firewall.sh", Category: "Security", Contents: "#!/bin/sh"})
+	assert.Nil(t, err)
+	assert.Equal(t, 11, script.ID)
+
+	// scripts without a category are not validated
+	_, err = j.CreateScript(&jamf.ScriptContents{Name: "noop.sh", Category: jamf.NoCategory, Contents: "#!/bin/sh"})
+	assert.Nil(t, err)
+
+	_, err = j.CreateScript(&jamf.ScriptContents{Name: "install-agent.sh", Category: "Monitoring", Contents: "#!/bin/sh"})
+	assert.True(t, errors.Is(err, jamf.ErrCategoryNotFound))
+
+	_, err = j.CreateScript(&jamf.ScriptContents{Name: "build.sh", Category: "Dev #1/Tools", Contents: "#!/bin/sh"})
+	assert.Nil(t, err)
+	_, err = j.CreateScript(&jamf.ScriptContents{Name: "test.sh", Category: "QA #2/Tools", Contents: "#!/bin/sh"})
+	assert.True(t, errors.As(err, &notFound))
+	assert.Equal(t, "QA #2/Tools", notFound.Category)
+	assert.Equal(t, int32(0), created)
+}
@@ -14,8 +14,13 @@ import (
 
 // Classes returns all mobile device classes
 func (j *Client) Classes() ([]Class, error) {
+	return j.ClassesContext(context.Background())
+}
+
+// ClassesContext is like Classes but uses the given context for the request
+func (j *Client) ClassesContext(ctx context.Context) ([]Class, error) {
 	ep := fmt.Sprintf("%s/%s", j.Endpoint, classesContext)
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building JAMF classes query request")
 	}
@@ -29,11 +34,16 @@ func (j *Client) Classes() ([]Class, error) {
 
 // ClassDetails returns the details for a specific mobile device class given its ID or Name
 func (j *Client) ClassDetails(identifier interface{}) (*ClassDetails, error) {
+	return j.ClassDetailsContext(context.Background(), identifier)
+}
+
+// ClassDetailsContext is like ClassDetails but uses the given context for the request
+func (j *Client) ClassDetailsContext(ctx context.Context, identifier interface{}) (*ClassDetails, error) {
 	ep, err := EndpointBuilder(j.Endpoint, classesContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query endpoint for class: %v", identifier)
 	}
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for class: %v", identifier)
 	}
@@ -48,6 +58,11 @@ func (j *Client) ClassDetails(identifier interface{}) (*ClassDetails, error) {
 
 // CreateClass will create a new mobile device class in Jamf
 func (j *Client) CreateClass(content *Class) (*Class, error) {
+	return j.CreateClassContext(context.Background(), content)
+}
+
+// CreateClassContext is like CreateClass but uses the given context for the request
+func (j *Client) CreateClassContext(ctx context.Context, content *Class) (*Class, error) {
 	ep, err := EndpointBuilder(j.Endpoint, classesContext, -1)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for new class")
@@ -67,7 +82,7 @@ func (j *Client) CreateClass(content *Class) (*Class, error) {
 	}
 
 	body := bytes.NewReader(bodyContent)
-	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF creation request for class: %v (%s)", content.Name, ep)
 	}
@@ -82,6 +97,11 @@ func (j *Client) CreateClass(content *Class) (*Class, error) {
 
 // UpdateClass will update a mobile device class in Jamf by either ID or Name
 func (j *Client) UpdateClass(identifier interface{}, content *Class) (*Class, error) {
+	return j.UpdateClassContext(context.Background(), identifier, content)
+}
+
+// UpdateClassContext is like UpdateClass but uses the given context for the request
+func (j *Client) UpdateClassContext(ctx context.Context, identifier interface{}, content *Class) (*Class, error) {
 	ep, err := EndpointBuilder(j.Endpoint, classesContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for class: %v", identifier)
@@ -93,7 +113,7 @@ func (j *Client) UpdateClass(identifier interface{}, content *Class) (*Class, er
 	}
 
 	body := bytes.NewReader(bodyContent)
-	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF update request for class: %v (%s)", identifier, ep)
 	}
@@ -108,12 +128,17 @@ func (j *Client) UpdateClass(identifier interface{}, content *Class) (*Class, er
 
 // DeleteClass will delete a mobile device class by either ID or Name
 func (j *Client) DeleteClass(identifier interface{}) (*Class, error) {
+	return j.DeleteClassContext(context.Background(), identifier)
+}
+
+// DeleteClassContext is like DeleteClass but uses the given context for the request
+func (j *Client) DeleteClassContext(ctx context.Context, identifier interface{}) (*Class, error) {
 	ep, err := EndpointBuilder(j.Endpoint, classesContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for class: %v", identifier)
 	}
 
-	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF deletion request for class %v", identifier)
 	}
@@ -14,31 +14,56 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 )
 
 const (
-	classesContext         = "classes"
-	computersContext       = "computers"
-	computerGroupsContext  = "computergroups"
-	computerExtAttrContext = "computerextensionattributes"
-	policiesContext        = "policies"
-	scriptsContext         = "scripts"
-	maxAuthAttempts        = 3
+	buildingsContext                         = "buildings"
+	categoriesContext                        = "categories"
+	classesContext                           = "classes"
+	computersContext                         = "computers"
+	computerCommandsContext                  = "computercommands"
+	computerGroupsContext                    = "computergroups"
+	computerExtAttrContext                   = "computerextensionattributes"
+	departmentsContext                       = "departments"
+	mobileDevicesContext                     = "mobiledevices"
+	mobileDeviceGroupsContext                = "mobiledevicegroups"
+	mobileDeviceConfigurationProfilesContext = "mobiledeviceconfigurationprofiles"
+	networkSegmentsContext                   = "networksegments"
+	osxConfigurationProfilesContext          = "osxconfigurationprofiles"
+	packagesContext                          = "packages"
+	policiesContext                          = "policies"
+	scriptsContext                           = "scripts"
+	sitesContext                             = "sites"
+	userGroupsContext                        = "usergroups"
+	usersContext                             = "users"
+	maxAuthAttempts                          = 3
+	// tokens are refreshed shortly before they expire so in-flight requests never carry an expired token
+	tokenRefreshWindow = 30 * time.Second
+	// bounds a shared token refresh since it outlives the request which started it
+	tokenRefreshTimeout = time.Minute
+	// once maxAuthAttempts consecutive token requests have failed the server isn't asked again for a while
+	authRetryBackoff = time.Minute
 )
 
 // Client represents the interface used to communicate with
 // the Jamf API via an HTTP client
 type Client struct {
-	Domain       string
-	Username     string
-	Password     string
-	Endpoint     string
-	authAttempts int
-	useAuthToken bool
-	authToken    *AuthToken
-	logger       *logrus.Logger
-	api          *http.Client
+	Domain             string
+	Username           string
+	Password           string
+	Endpoint           string
+	useAuthToken       bool
+	tokens             *tokenManager
+	logger             Logger
+	doer               Doer
+	retryPolicy        *RetryPolicy
+	limiter            *limiter
+	oauth              *oauthCredentials
+	provider           CredentialsProvider
+	telemetry          *instrumentation
+	validateCategories bool
+	locations          *LocationResolver
+	site               *Site
 }
 
 // Used if custom client not passed on when NewClient instantiated
@@ -48,110 +73,186 @@ func defaultHTTPClient() *http.Client {
 	}
 }
 
-// NewClient returns a new Jamf HTTP client to be used for API requests
+// NewClient returns a new Jamf HTTP client to be used for API requests. The username and password
+// may be left empty when the client is configured using WithOAuthClientCredentials or WithCredentialsProvider
 func NewClient(domain string, username string, password string, client *http.Client, opts ...Option) (*Client, error) {
-	if domain == "" || username == "" || password == "" {
-		return nil, errors.New("you must provide a valid Jamf domain, username, and password")
-	}
-
 	o, err := resolveOptions(opts)
 	if err != nil {
 		return nil, err
 	}
 
+	if domain == "" || (o.oauth == nil && o.provider == nil && (username == "" || password == "")) {
+		return nil, errors.New("you must provide a valid Jamf domain, username, and password")
+	}
+
 	if client == nil {
 		client = defaultHTTPClient()
 	}
 
-	return &Client{
-		Domain:       domain,
-		Username:     username,
-		Password:     password,
-		Endpoint:     fmt.Sprintf("%s/JSSResource", domain),
-		authToken:    &AuthToken{},
-		useAuthToken: o.useTokenAuth,
-		authAttempts: 0,
-		api:          client,
-	}, nil
+	var l *limiter
+	if o.rateLimit != nil {
+		l = newLimiter(o.rateLimit)
+	}
+
+	telemetry, err := newInstrumentation(o.tracerProvider, o.meterProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set up client instrumentation")
+	}
+
+	j := &Client{
+		Domain:             domain,
+		Username:           username,
+		Password:           password,
+		Endpoint:           fmt.Sprintf("%s/JSSResource", domain),
+		useAuthToken:       o.useTokenAuth || o.oauth != nil,
+		tokens:             newTokenManager(),
+		logger:             o.logger,
+		doer:               chainMiddleware(client, o.middleware),
+		retryPolicy:        o.retryPolicy,
+		limiter:            l,
+		oauth:              o.oauth,
+		provider:           o.provider,
+		telemetry:          telemetry,
+		validateCategories: o.validateCategories,
+		site:               o.site,
+	}
+	if o.validateLocations {
+		j.locations = j.NewLocationResolver()
+	}
+	return j, nil
 }
 
 // GetAuthToken will retrieve a bearer token using basic auth credentials which is now
 // required for newer server versions https://developer.jamf.com/jamf-pro/docs/getting-started-2#bearer-tokens
 // https://developer.jamf.com/jamf-pro/docs/classic-api-authentication-changes
+// When the client is configured with WithOAuthClientCredentials an access token is retrieved instead
 func (j *Client) GetAuthToken() error {
+	return j.GetAuthTokenContext(context.Background())
+}
+
+// GetAuthTokenContext is like GetAuthToken but uses the given context for the request
+func (j *Client) GetAuthTokenContext(ctx context.Context) error {
+	token, err := j.fetchToken(ctx)
+	j.tokens.store(token, err)
+	j.telemetry.recordAuthRefresh(ctx, err)
+	return err
+}
+
+// getBasicAuthToken exchanges the basic auth credentials for a bearer token
+func (j *Client) getBasicAuthToken(ctx context.Context) (*AuthToken, error) {
 	ep := fmt.Sprintf("%s/api/v1/auth/token", j.Domain)
-	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	req.SetBasicAuth(j.Username, j.Password)
-
-	j.authAttempts += 1
+	creds, err := j.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(creds.Username, creds.Password)
 
-	res, err := j.api.Do(req)
+	// fetching a token has no side effects so it is safe to retry
+	res, err := j.do(req, true)
 	if err != nil {
-		return errors.Wrapf(err, "error making %s request to %s", req.Method, req.URL)
+		return nil, errors.Wrapf(err, "error making %s request to %s", req.Method, req.URL)
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return errors.Wrapf(err, "error making %s request to %s: %d", req.Method, req.URL, res.StatusCode)
+		responseData, _ := io.ReadAll(res.Body)
+		return nil, errors.Wrapf(newAPIError(res, responseData), "error making %s request to %s", req.Method, req.URL)
 	}
 
 	var token AuthToken
 	if err = json.NewDecoder(res.Body).Decode(&token); err != nil {
-		return errors.Wrap(err, "response was successful but error occurred error decoding response body")
+		return nil, errors.Wrap(err, "response was successful but error occurred error decoding response body")
 	}
 
-	j.authToken = &token
-
-	return nil
+	return &token, nil
 }
 
+// AuthToken returns the bearer token currently used by the client
 func (j *Client) AuthToken() *AuthToken {
-	return j.authToken
+	return j.tokens.current()
 }
 
-func (j *Client) makeAPIrequest(r *http.Request, v interface{}) error {
-	// Jamf API only sends XML for some endpoints so we will accept both but prioritize
-	// JSON responses with the quallity value of 1.0 and 0.9 for XML responses
-	// https://developer.mozilla.org/en-US/docs/Glossary/quality_values
-	r.Header.Set("Accept", "application/json, application/xml;q=0.9")
-	r.Header.Set("Cache-Control", "no-store, no-cache, must-revalidate, max-age=0, post-check=0, pre-check=0")
-	r.Header.Set("Strict-Transport-Security", "max-age=31536000 ; includeSubDomains")
+// Do sends a request to the Jamf Pro API (i.e /api/v1/...) using the client's bearer token, retry
+// policy, rate limit, middleware, logging and instrumentation. A bearer token is always used since
+// the Jamf Pro API does not accept basic auth. An *APIError is returned for unsuccessful responses,
+// otherwise the caller is responsible for closing the response body
+func (j *Client) Do(r *http.Request) (*http.Response, error) {
+	return j.request(r, true)
+}
+
+// request authenticates and sends the request returning an *APIError when the status code is not 2xx
+func (j *Client) request(r *http.Request, bearer bool) (res *http.Response, err error) {
+	ctx, span, attrs := j.telemetry.startRequest(r)
+	start, status := time.Now(), 0
+	defer func() { j.telemetry.endRequest(ctx, span, attrs, status, start, err) }()
+	// the span context is propagated to the token requests and middleware
+	r = r.WithContext(ctx)
 
-	if j.useAuthToken {
-		expired, err := j.authToken.IsExpired()
+	var token *AuthToken
+	if bearer {
+		token, err = j.bearerToken(r.Context())
 		if err != nil {
-			return err
+			return nil, errors.Wrapf(err, "error making %s request to %s: unauthorized", r.Method, r.URL)
 		}
 
-		if expired {
-			if j.authAttempts < maxAuthAttempts {
-				if err := j.GetAuthToken(); err != nil {
-					return errors.Wrapf(err, "error making %s request to %s: unauthorized", r.Method, r.URL)
-				}
-			}
+		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.Token))
+	} else {
+		creds, err := j.credentials(r.Context())
+		if err != nil {
+			return nil, errors.Wrapf(err, "error making %s request to %s", r.Method, r.URL)
 		}
+		r.SetBasicAuth(creds.Username, creds.Password)
+	}
 
-		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", j.authToken.Token))
-	} else {
-		r.SetBasicAuth(j.Username, j.Password)
+	res, err = j.do(r, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error making %s request to %s", r.Method, r.URL)
+	}
+	status = res.StatusCode
+
+	// The token was rejected (i.e invalidated server side) so drop it to fetch a new one on the next request
+	if res.StatusCode == http.StatusUnauthorized && token != nil {
+		j.tokens.clear(token)
 	}
 
-	res, err := j.api.Do(r)
+	// If status code is not ok attempt to read the response in plain text
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		defer res.Body.Close()
+		responseData, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, errors.Wrapf(err, "request error: %s. unable to retrieve plain text response: %s", res.Status, err.Error())
+		}
+		return nil, newAPIError(res, responseData)
+	}
+
+	return res, nil
+}
+
+func (j *Client) makeAPIrequest(r *http.Request, v interface{}) error {
+	// Jamf API only sends XML for some endpoints so we will accept both but prioritize
+	// JSON responses with the quallity value of 1.0 and 0.9 for XML responses
+	// https://developer.mozilla.org/en-US/docs/Glossary/quality_values
+	r.Header.Set("Accept", "application/json, application/xml;q=0.9")
+	r.Header.Set("Cache-Control", "no-store, no-cache, must-revalidate, max-age=0, post-check=0, pre-check=0")
+	r.Header.Set("Strict-Transport-Security", "max-age=31536000 ; includeSubDomains")
+
+	res, err := j.request(r, j.useAuthToken)
 	if err != nil {
-		return errors.Wrapf(err, "error making %s request to %s", r.Method, r.URL)
+		return err
 	}
 	defer res.Body.Close()
 
-	// If status code is not ok attempt to read the response in plain text
+	// The classic API only responds with 200 or 201 on success
 	if res.StatusCode != 200 && res.StatusCode != 201 {
 		responseData, err := io.ReadAll(res.Body)
 		if err != nil {
 			return errors.Wrapf(err, "request error: %s. unable to retrieve plain text response: %s", res.Status, err.Error())
 		}
-		return fmt.Errorf("request error: %s", string(responseData))
+		return newAPIError(res, responseData)
 	}
 
 	// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/X-Content-Type-Options
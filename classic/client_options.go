@@ -1,13 +1,35 @@
 package classic
 
+import (
+	"log/slog"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
 type Option func(*Options) error
 type Options struct {
-	useTokenAuth bool
+	useTokenAuth       bool
+	retryPolicy        *RetryPolicy
+	rateLimit          *RateLimit
+	oauth              *oauthCredentials
+	provider           CredentialsProvider
+	middleware         []Middleware
+	logger             Logger
+	validateCategories bool
+	validateLocations  bool
+	site               *Site
+
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
 }
 
 func resolveOptions(opts []Option) (*Options, error) {
 	o := &Options{
 		useTokenAuth: false,
+		logger:       discardLogger(),
 	}
 	for _, option := range opts {
 		err := option(o)
@@ -24,3 +46,159 @@ func WithTokenAuth() Option {
 		return nil
 	}
 }
+
+// WithOAuthClientCredentials authenticates using a Jamf Pro API client instead of a user account.
+// Access tokens are retrieved from /api/oauth/token, cached and refreshed before they expire
+// https://developer.jamf.com/jamf-pro/docs/client-credentials
+func WithOAuthClientCredentials(clientID string, clientSecret string) Option {
+	return func(o *Options) error {
+		if clientID == "" || clientSecret == "" {
+			return errors.New("you must provide a valid Jamf API client ID and client secret")
+		}
+		o.oauth = &oauthCredentials{
+			clientID:     clientID,
+			clientSecret: clientSecret,
+		}
+		return nil
+	}
+}
+
+// WithCredentialsProvider retrieves the username and password from the given provider whenever
+// the client needs to authenticate instead of using the values passed to NewClient
+func WithCredentialsProvider(p CredentialsProvider) Option {
+	return func(o *Options) error {
+		if p == nil {
+			return errors.New("credentials provider must not be nil")
+		}
+		o.provider = p
+		return nil
+	}
+}
+
+// WithMiddleware registers middleware that runs around every HTTP call made to Jamf. Middleware
+// is applied in the order given so the first one sees the request first and the response last
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(o *Options) error {
+		for _, m := range middleware {
+			if m == nil {
+				return errors.New("middleware must not be nil")
+			}
+		}
+		o.middleware = append(o.middleware, middleware...)
+		return nil
+	}
+}
+
+// WithLogger logs every request made by the client (method, URL, status, duration and attempt)
+// using the given logger, *slog.Logger can be passed directly. Credentials and tokens are never logged
+func WithLogger(l Logger) Option {
+	return func(o *Options) error {
+		if l == nil {
+			return errors.New("logger must not be nil")
+		}
+		o.logger = l
+		return nil
+	}
+}
+
+// WithLogHandler is like WithLogger but builds the logger from a log/slog handler
+func WithLogHandler(h slog.Handler) Option {
+	return func(o *Options) error {
+		if h == nil {
+			return errors.New("log handler must not be nil")
+		}
+		o.logger = slog.New(h)
+		return nil
+	}
+}
+
+// WithInstrumentation enables OpenTelemetry instrumentation. A span is created for every API call
+// tagged with the resource, HTTP method, identifier type and status code, and request count, latency
+// and auth refresh metrics are recorded. The global providers are used when nil is passed
+func WithInstrumentation(tp trace.TracerProvider, mp metric.MeterProvider) Option {
+	return func(o *Options) error {
+		if tp == nil {
+			tp = otel.GetTracerProvider()
+		}
+		if mp == nil {
+			mp = otel.GetMeterProvider()
+		}
+		o.tracerProvider = tp
+		o.meterProvider = mp
+		return nil
+	}
+}
+
+// WithRetry enables retrying transient failures using the DefaultRetryPolicy
+func WithRetry() Option {
+	return WithRetryPolicy(DefaultRetryPolicy())
+}
+
+// WithRetryPolicy enables retrying transient failures (connection errors, 429, 502, 503 and 504
+// responses) using the given policy. Only idempotent requests are retried unless the policy
+// explicitly allows otherwise
+func WithRetryPolicy(p *RetryPolicy) Option {
+	return func(o *Options) error {
+		if p == nil {
+			return errors.New("retry policy must not be nil")
+		}
+		if err := p.validate(); err != nil {
+			return err
+		}
+		policy := *p
+		o.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithRateLimit throttles requests sent by the client using a token bucket and caps the number
+// of in-flight requests. Wait time statistics are available using Client.RateLimitStats
+func WithRateLimit(l *RateLimit) Option {
+	return func(o *Options) error {
+		if l == nil {
+			return errors.New("rate limit must not be nil")
+		}
+		if err := l.validate(); err != nil {
+			return err
+		}
+		limit := *l
+		o.rateLimit = &limit
+		return nil
+	}
+}
+
+// WithCategoryValidation checks that the category referenced by a new policy or script exists before
+// it is created and fails early with a *CategoryNotFoundError when it doesn't
+func WithCategoryValidation() Option {
+	return func(o *Options) error {
+		o.validateCategories = true
+		return nil
+	}
+}
+
+// WithLocationValidation checks that the buildings and departments referenced by the scope of a new or
+// updated policy, or by the location of an updated computer, exist before the request is sent and fails
+// early with an *UnknownLocationError when they don't. Buildings and departments are fetched on first use
+// and cached for the lifetime of the client
+func WithLocationValidation() Option {
+	return func(o *Options) error {
+		o.validateLocations = true
+		return nil
+	}
+}
+
+// WithSite pins the client to a single site. Listing policies, scripts, groups and configuration profiles
+// only returns the ones in the site and creating them defaults to the site when none is set, while
+// creating, reading, updating or deleting them in any other site fails with a *SiteMismatchError. Since
+// the classic API doesn't return sites when listing objects, each list call makes an additional request
+// per item to look up its site, running up to 8 of them at a time, and updates and deletes look up the
+// site of the existing object first
+func WithSite(site Site) Option {
+	return func(o *Options) error {
+		if site.ID <= 0 && site.Name == "" {
+			return errors.New("you must provide a valid Jamf site ID or name")
+		}
+		o.site = &Site{ID: site.ID, Name: site.Name}
+		return nil
+	}
+}
@@ -114,3 +114,22 @@ func TestBadNewClient(t *testing.T) {
 	assert.Equal(t, "you must provide a valid Jamf domain, username, and password", err.Error())
 	assert.Nil(t, j)
 }
+
+func TestClientRequestContextCanceled(t *testing.T) {
+	testServer := clientResponseMock(t)
+	defer testServer.Close()
+
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithTokenAuth())
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = j.GetAuthTokenContext(ctx)
+	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = j.ComputersContext(ctx)
+	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+}
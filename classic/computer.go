@@ -38,8 +38,13 @@ func (identifier *ComputerIdentifier) endpoint(endpoint string, context string)
 
 // Computers returns all enrolled computer devices
 func (j *Client) Computers() ([]BasicComputerInfo, error) {
+	return j.ComputersContext(context.Background())
+}
+
+// ComputersContext is like Computers but uses the given context for the request
+func (j *Client) ComputersContext(ctx context.Context) ([]BasicComputerInfo, error) {
 	ep := fmt.Sprintf("%s/%s", j.Endpoint, computersContext)
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building JAMF computer query request")
 	}
@@ -53,11 +58,16 @@ func (j *Client) Computers() ([]BasicComputerInfo, error) {
 
 // ComputerDetails returns the details for a specific computer given its ID
 func (j *Client) ComputerDetails(identifier interface{}) (*Computer, error) {
+	return j.ComputerDetailsContext(context.Background(), identifier)
+}
+
+// ComputerDetailsContext is like ComputerDetails but uses the given context for the request
+func (j *Client) ComputerDetailsContext(ctx context.Context, identifier interface{}) (*Computer, error) {
 	ep, err := EndpointBuilder(j.Endpoint, computersContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for computer: %v", identifier)
 	}
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF computer request for computer: %v (%s)", identifier, ep)
 	}
@@ -71,8 +81,13 @@ func (j *Client) ComputerDetails(identifier interface{}) (*Computer, error) {
 
 // GetComputer takes in a search option and returns the details for a specific computer
 func (j *Client) GetComputer(identifier *ComputerIdentifier) (*Computer, error) {
+	return j.GetComputerContext(context.Background(), identifier)
+}
+
+// GetComputerContext is like GetComputer but uses the given context for the request
+func (j *Client) GetComputerContext(ctx context.Context, identifier *ComputerIdentifier) (*Computer, error) {
 	ep := identifier.endpoint(j.Endpoint, computersContext)
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF computer request for computer: %s", ep)
 	}
@@ -86,14 +101,23 @@ func (j *Client) GetComputer(identifier *ComputerIdentifier) (*Computer, error)
 
 // UpdateComputer takes in an identifier and updated content and updates the device on the server
 func (j *Client) UpdateComputer(identifier *ComputerIdentifier, updates *ComputerDetails) (*ComputerDetails, error) {
+	return j.UpdateComputerContext(context.Background(), identifier, updates)
+}
+
+// UpdateComputerContext is like UpdateComputer but uses the given context for the request
+func (j *Client) UpdateComputerContext(ctx context.Context, identifier *ComputerIdentifier, updates *ComputerDetails) (*ComputerDetails, error) {
 	ep := identifier.endpoint(j.Endpoint, computersContext)
+	if err := j.validateComputerLocation(ctx, updates); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for computer: %v (%s)", identifier, ep)
+	}
+
 	content, err := xml.Marshal(updates)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF update payload for computer: %v", identifier)
 	}
 
 	body := bytes.NewReader(content)
-	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF update request for computer: %v (%s)", identifier, ep)
 	}
@@ -0,0 +1,210 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SendComputerCommand sends a command which doesn't take any arguments to the given computers and
+// returns the UUID of the command queued for each of them, commands which take arguments such as
+// DeviceLock must be sent with their own method
+func (j *Client) SendComputerCommand(command ComputerCommand, computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.SendComputerCommandContext(context.Background(), command, computerIDs...)
+}
+
+// SendComputerCommandContext is like SendComputerCommand but uses the given context for the request
+func (j *Client) SendComputerCommandContext(ctx context.Context, command ComputerCommand, computerIDs ...int) ([]ComputerCommandResult, error) {
+	switch command {
+	case ComputerCommandDeviceLock, ComputerCommandEraseDevice, ComputerCommandScheduleOSUpdate:
+		return nil, errors.Errorf("unable to send %s command: use %s which takes the required arguments", command, command)
+	}
+	return j.sendComputerCommand(ctx, ComputerCommandGeneral{Command: command}, computerIDs)
+}
+
+// BlankPush sends a blank push to the given computers prompting them to check in with Jamf
+func (j *Client) BlankPush(computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.BlankPushContext(context.Background(), computerIDs...)
+}
+
+// BlankPushContext is like BlankPush but uses the given context for the request
+func (j *Client) BlankPushContext(ctx context.Context, computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.SendComputerCommandContext(ctx, ComputerCommandBlankPush, computerIDs...)
+}
+
+// UpdateInventory requests an inventory update from the given computers
+func (j *Client) UpdateInventory(computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.UpdateInventoryContext(context.Background(), computerIDs...)
+}
+
+// UpdateInventoryContext is like UpdateInventory but uses the given context for the request
+func (j *Client) UpdateInventoryContext(ctx context.Context, computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.SendComputerCommandContext(ctx, ComputerCommandUpdateInventory, computerIDs...)
+}
+
+// DeviceLock locks the given computers, the six digit passcode is required to unlock them
+func (j *Client) DeviceLock(passcode string, computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.DeviceLockContext(context.Background(), passcode, computerIDs...)
+}
+
+// DeviceLockContext is like DeviceLock but uses the given context for the request
+func (j *Client) DeviceLockContext(ctx context.Context, passcode string, computerIDs ...int) ([]ComputerCommandResult, error) {
+	if err := validatePasscode(passcode); err != nil {
+		return nil, errors.Wrapf(err, "unable to send %s command", ComputerCommandDeviceLock)
+	}
+	return j.sendComputerCommand(ctx, ComputerCommandGeneral{Command: ComputerCommandDeviceLock, Passcode: passcode}, computerIDs)
+}
+
+// EraseDevice erases the given computers, the six digit passcode is used to lock them once erased
+func (j *Client) EraseDevice(passcode string, computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.EraseDeviceContext(context.Background(), passcode, computerIDs...)
+}
+
+// EraseDeviceContext is like EraseDevice but uses the given context for the request
+func (j *Client) EraseDeviceContext(ctx context.Context, passcode string, computerIDs ...int) ([]ComputerCommandResult, error) {
+	if err := validatePasscode(passcode); err != nil {
+		return nil, errors.Wrapf(err, "unable to send %s command", ComputerCommandEraseDevice)
+	}
+	return j.sendComputerCommand(ctx, ComputerCommandGeneral{Command: ComputerCommandEraseDevice, Passcode: passcode}, computerIDs)
+}
+
+// RemoveMDMProfile removes the MDM profile from the given computers
+func (j *Client) RemoveMDMProfile(computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.RemoveMDMProfileContext(context.Background(), computerIDs...)
+}
+
+// RemoveMDMProfileContext is like RemoveMDMProfile but uses the given context for the request
+func (j *Client) RemoveMDMProfileContext(ctx context.Context, computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.SendComputerCommandContext(ctx, ComputerCommandRemoveMDMProfile, computerIDs...)
+}
+
+// UnmanageDevice removes the MDM profile and management framework from the given computers
+func (j *Client) UnmanageDevice(computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.UnmanageDeviceContext(context.Background(), computerIDs...)
+}
+
+// UnmanageDeviceContext is like UnmanageDevice but uses the given context for the request
+func (j *Client) UnmanageDeviceContext(ctx context.Context, computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.SendComputerCommandContext(ctx, ComputerCommandUnmanageDevice, computerIDs...)
+}
+
+// ScheduleOSUpdate schedules a macOS update on the given computers which are either only downloaded
+// or downloaded and installed depending on the action
+func (j *Client) ScheduleOSUpdate(action OSUpdateAction, computerIDs ...int) ([]ComputerCommandResult, error) {
+	return j.ScheduleOSUpdateContext(context.Background(), action, computerIDs...)
+}
+
+// ScheduleOSUpdateContext is like ScheduleOSUpdate but uses the given context for the request
+func (j *Client) ScheduleOSUpdateContext(ctx context.Context, action OSUpdateAction, computerIDs ...int) ([]ComputerCommandResult, error) {
+	if action != OSUpdateActionDownload && action != OSUpdateActionDownloadAndInstall {
+		return nil, errors.Errorf("unable to send %s command: invalid action %d", ComputerCommandScheduleOSUpdate, action)
+	}
+
+	ids, err := joinComputerIDs(computerIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to send %s command", ComputerCommandScheduleOSUpdate)
+	}
+
+	ep := fmt.Sprintf("%s/%s/command/%s/action/%d/id/%s", j.Endpoint, computerCommandsContext, ComputerCommandScheduleOSUpdate, action, ids)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF %s command request (%s)", ComputerCommandScheduleOSUpdate, ep)
+	}
+
+	res := computerCommandResults{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF %s command request (%s)", ComputerCommandScheduleOSUpdate, ep)
+	}
+	return res.Info.Commands, nil
+}
+
+// ComputerCommandStatus returns a command sent to computers along with its status given its UUID
+func (j *Client) ComputerCommandStatus(uuid string) (*ComputerCommandDetails, error) {
+	return j.ComputerCommandStatusContext(context.Background(), uuid)
+}
+
+// ComputerCommandStatusContext is like ComputerCommandStatus but uses the given context for the request
+func (j *Client) ComputerCommandStatusContext(ctx context.Context, uuid string) (*ComputerCommandDetails, error) {
+	if uuid == "" {
+		return nil, errors.New("error building JAMF computer command query request: command UUID is required")
+	}
+
+	ep := fmt.Sprintf("%s/%s/uuid/%s", j.Endpoint, computerCommandsContext, url.PathEscape(uuid))
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for computer command: %s", uuid)
+	}
+
+	res := computerCommandResponse{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query computer command: %s from %s", uuid, ep)
+	}
+	return &res.Details, nil
+}
+
+// sendComputerCommand sends the command in the request body rather than the URL so passcodes
+// don't end up in request logs
+func (j *Client) sendComputerCommand(ctx context.Context, general ComputerCommandGeneral, computerIDs []int) ([]ComputerCommandResult, error) {
+	if _, err := joinComputerIDs(computerIDs); err != nil {
+		return nil, errors.Wrapf(err, "unable to send %s command", general.Command)
+	}
+
+	command := &ComputerCommandDetails{General: general}
+	for _, id := range computerIDs {
+		command.Computers = append(command.Computers, ComputerCommandTarget{ID: id})
+	}
+
+	bodyContent, err := xml.Marshal(command)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF %s command payload", general.Command)
+	}
+
+	ep := fmt.Sprintf("%s/%s/command/%s", j.Endpoint, computerCommandsContext, general.Command)
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF %s command request (%s)", general.Command, ep)
+	}
+
+	res := computerCommandResults{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF %s command request (%s)", general.Command, ep)
+	}
+	return res.Info.Commands, nil
+}
+
+func joinComputerIDs(computerIDs []int) (string, error) {
+	if len(computerIDs) == 0 {
+		return "", errors.New("at least one computer ID is required")
+	}
+	ids := make([]string, len(computerIDs))
+	for i, id := range computerIDs {
+		if id <= 0 {
+			return "", errors.Errorf("invalid computer ID: %d", id)
+		}
+		ids[i] = strconv.Itoa(id)
+	}
+	return strings.Join(ids, ","), nil
+}
+
+func validatePasscode(passcode string) error {
+	if len(passcode) != 6 {
+		return errors.New("passcode must be six digits")
+	}
+	for _, c := range passcode {
+		if c < '0' || c > '9' {
+			return errors.New("passcode must be six digits")
+		}
+	}
+	return nil
+}
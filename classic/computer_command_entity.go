@@ -0,0 +1,88 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "encoding/xml"
+
+// ComputerCommand is the name of an MDM command which can be sent to computers
+type ComputerCommand string
+
+// Computer commands supported by the classic API
+const (
+	ComputerCommandBlankPush                ComputerCommand = "BlankPush"
+	ComputerCommandUpdateInventory          ComputerCommand = "UpdateInventory"
+	ComputerCommandDeviceLock               ComputerCommand = "DeviceLock"
+	ComputerCommandEraseDevice              ComputerCommand = "EraseDevice"
+	ComputerCommandRemoveMDMProfile         ComputerCommand = "RemoveMDMProfile"
+	ComputerCommandUnmanageDevice           ComputerCommand = "UnmanageDevice"
+	ComputerCommandScheduleOSUpdate         ComputerCommand = "ScheduleOSUpdate"
+	ComputerCommandEnableRemoteDesktop      ComputerCommand = "EnableRemoteDesktop"
+	ComputerCommandDisableRemoteDesktop     ComputerCommand = "DisableRemoteDesktop"
+	ComputerCommandSettingsEnableBluetooth  ComputerCommand = "SettingsEnableBluetooth"
+	ComputerCommandSettingsDisableBluetooth ComputerCommand = "SettingsDisableBluetooth"
+)
+
+// OSUpdateAction is the action taken by computers receiving a ScheduleOSUpdate command
+type OSUpdateAction int
+
+// OS update actions
+const (
+	OSUpdateActionDownload           OSUpdateAction = 1
+	OSUpdateActionDownloadAndInstall OSUpdateAction = 2
+)
+
+// ComputerCommandDetails holds a command sent to computers along with its status on each of them
+type ComputerCommandDetails struct {
+	XMLName   xml.Name                `json:"-" xml:"computer_command,omitempty"`
+	General   ComputerCommandGeneral  `json:"general" xml:"general"`
+	Computers []ComputerCommandTarget `json:"computers" xml:"computers>computer,omitempty"`
+}
+
+// ComputerCommandGeneral holds the command and the passcode used by DeviceLock and EraseDevice
+type ComputerCommandGeneral struct {
+	Command  ComputerCommand `json:"command" xml:"command"`
+	Passcode string          `json:"passcode,omitempty" xml:"passcode,omitempty"`
+}
+
+// ComputerCommandTarget represents a computer a command was sent to and the status of the command on it
+type ComputerCommandTarget struct {
+	ID     int    `json:"id" xml:"id"`
+	Name   string `json:"name,omitempty" xml:"name,omitempty"`
+	Status string `json:"status,omitempty" xml:"status,omitempty"`
+}
+
+// ComputerCommandResult holds the UUID of a command queued for a computer, it can be used
+// to look up the status of the command using ComputerCommandStatus
+type ComputerCommandResult struct {
+	Name       ComputerCommand `json:"name" xml:"name"`
+	UUID       string          `json:"command_uuid" xml:"command_uuid"`
+	ComputerID int             `json:"computer_id" xml:"computer_id"`
+}
+
+// computerCommandResults unwraps the commands queued by the classic API
+type computerCommandResults struct {
+	Info computerCommandResultList `json:"computer_command"`
+}
+
+// computerCommandResultList holds a command queued for each of the computers it was sent to
+type computerCommandResultList struct {
+	Commands []ComputerCommandResult `json:"command" xml:"command"`
+}
+
+// UnmarshalXML decodes the computer_command element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (c *computerCommandResults) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&c.Info, &start)
+}
+
+// computerCommandResponse unwraps the command returned by the classic API
+type computerCommandResponse struct {
+	Details ComputerCommandDetails `json:"computer_command"`
+}
+
+// UnmarshalXML decodes the computer_command element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (c *computerCommandResponse) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&c.Details, &start)
+}
@@ -0,0 +1,183 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var COMPUTER_COMMANDS_BASE_API_ENDPOINT = "/JSSResource/computercommands"
+
+// computerCommandsResponseMocks queues a command for every computer in the request and records the URLs requested
+func computerCommandsResponseMocks(t *testing.T, requested *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requested = append(*requested, r.RequestURI)
+		switch {
+		case r.RequestURI == fmt.Sprintf("%s/command/ScheduleOSUpdate/action/2/id/1,2", COMPUTER_COMMANDS_BASE_API_ENDPOINT):
+			assert.Equal(t, "POST", r.Method)
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<computer_command>
+					<command><name>ScheduleOSUpdate</name><command_uuid>5c1d4b9e-0001</command_uuid><computer_id>1</computer_id></command>
+					<command><name>ScheduleOSUpdate</name><command_uuid>5c1d4b9e-0002</command_uuid><computer_id>2</computer_id></command>
+				</computer_command>`)
+		case strings.HasPrefix(r.RequestURI, fmt.Sprintf("%s/command/", COMPUTER_COMMANDS_BASE_API_ENDPOINT)):
+			assert.Equal(t, "POST", r.Method)
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			command := &jamf.ComputerCommandDetails{}
+			assert.Nil(t, xml.Unmarshal(data, command))
+			assert.Equal(t, strings.TrimPrefix(r.RequestURI, COMPUTER_COMMANDS_BASE_API_ENDPOINT+"/command/"), string(command.General.Command))
+
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><computer_command>`)
+			for _, computer := range command.Computers {
+				fmt.Fprintf(w, `<command><name>%s</name><command_uuid>%s-%d</command_uuid><computer_id>%d</computer_id></command>`, command.General.Command, command.General.Passcode, computer.ID, computer.ID)
+			}
+			fmt.Fprint(w, `</computer_command>`)
+		case r.RequestURI == fmt.Sprintf("%s/uuid/a3c6d8f0-1b2e-4f5a-9c7d-2e4f6a8b0c1d", COMPUTER_COMMANDS_BASE_API_ENDPOINT):
+			fmt.Fprint(w, `{
+				"computer_command": {
+					"general": {
+						"command": "DeviceLock",
+						"passcode": "123456"
+					},
+					"computers": [
+						{
+							"id": 7,
+							"name": "Stolen MacBook Pro",
+							"status": "Pending"
+						}
+					]
+				}
+			}`)
+		case r.RequestURI == fmt.Sprintf("%s/uuid/b7e2c4a1-3d5f-4e6a-8b9c-0d1e2f3a4b5c", COMPUTER_COMMANDS_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<computer_command>
+					<general><command>BlankPush</command></general>
+					<computers>
+						<computer><id>1</id><name>Test MacBook #1</name><status>Completed</status></computer>
+						<computer><id>2</id><name>Test MacBook #2</name><status>Failed</status></computer>
+					</computers>
+				</computer_command>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestComputerCommands(t *testing.T) {
+	requested := []string{}
+	testServer := computerCommandsResponseMocks(t, &requested)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	results, err := j.BlankPush(1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.ComputerCommandResult{
+		{Name: jamf.ComputerCommandBlankPush, UUID: "-1", ComputerID: 1},
+		{Name: jamf.ComputerCommandBlankPush, UUID: "-2", ComputerID: 2},
+	}, results)
+
+	for command, send := range map[jamf.ComputerCommand]func(...int) ([]jamf.ComputerCommandResult, error){
+		jamf.ComputerCommandUpdateInventory:  j.UpdateInventory,
+		jamf.ComputerCommandRemoveMDMProfile: j.RemoveMDMProfile,
+		jamf.ComputerCommandUnmanageDevice:   j.UnmanageDevice,
+	} {
+		results, err = send(3)
+		assert.Nil(t, err)
+		assert.Equal(t, command, results[0].Name)
+		assert.Equal(t, 3, results[0].ComputerID)
+	}
+
+	results, err = j.SendComputerCommand(jamf.ComputerCommandEnableRemoteDesktop, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.ComputerCommandEnableRemoteDesktop, results[0].Name)
+
+	results, err = j.ScheduleOSUpdate(jamf.OSUpdateActionDownloadAndInstall, 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, "5c1d4b9e-0002", results[1].UUID)
+
+	// commands taking arguments must use their own method
+	_, err = j.SendComputerCommand(jamf.ComputerCommandDeviceLock, 4)
+	assert.NotNil(t, err)
+
+	// computer IDs are required
+	_, err = j.BlankPush()
+	assert.NotNil(t, err)
+	_, err = j.UpdateInventory(0)
+	assert.NotNil(t, err)
+	_, err = j.ScheduleOSUpdate(jamf.OSUpdateAction(3), 1)
+	assert.NotNil(t, err)
+}
+
+func TestDeviceLockAndEraseDevice(t *testing.T) {
+	requested := []string{}
+	testServer := computerCommandsResponseMocks(t, &requested)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	results, err := j.DeviceLock("123456", 7)
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.ComputerCommandDeviceLock, results[0].Name)
+	// the mock echoes the passcode it received in the body
+	assert.Equal(t, "123456-7", results[0].UUID)
+
+	results, err = j.EraseDevice("654321", 7, 8)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, "654321-8", results[1].UUID)
+
+	// passcodes are never sent in the URL
+	for _, uri := range requested {
+		assert.NotContains(t, uri, "123456")
+		assert.NotContains(t, uri, "654321")
+	}
+
+	for _, passcode := range []string{"", "12345", "1234567", "12a456"} {
+		_, err = j.DeviceLock(passcode, 7)
+		assert.NotNil(t, err)
+		_, err = j.EraseDevice(passcode, 7)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 2, len(requested))
+}
+
+func TestComputerCommandStatus(t *testing.T) {
+	requested := []string{}
+	testServer := computerCommandsResponseMocks(t, &requested)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	// JSON
+	command, err := j.ComputerCommandStatus("a3c6d8f0-1b2e-4f5a-9c7d-2e4f6a8b0c1d")
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.ComputerCommandDeviceLock, command.General.Command)
+	assert.Equal(t, []jamf.ComputerCommandTarget{{ID: 7, Name: "Stolen MacBook Pro", Status: "Pending"}}, command.Computers)
+
+	// XML
+	command, err = j.ComputerCommandStatus("b7e2c4a1-3d5f-4e6a-8b9c-0d1e2f3a4b5c")
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.ComputerCommandBlankPush, command.General.Command)
+	assert.Equal(t, 2, len(command.Computers))
+	assert.Equal(t, "Failed", command.Computers[1].Status)
+
+	_, err = j.ComputerCommandStatus("")
+	assert.NotNil(t, err)
+}
@@ -9,19 +9,23 @@ import (
 	"encoding/xml"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/pkg/errors"
 )
 
 // ComputerExtensionAttrExists is a helper function to check if an extension attribute
-// exists without having to parse the response. Note: If an error occurs that doesn't include
-// a not found message ... we log the error and return false
+// exists without having to parse the response. Note: If an error occurs that isn't an
+// ErrNotFound ... we log the error and return false
 func (j *Client) ComputerExtensionAttrExists(identifier interface{}) bool {
-	_, err := j.ComputerExtensionAttributeDetails(identifier)
+	return j.ComputerExtensionAttrExistsContext(context.Background(), identifier)
+}
+
+// ComputerExtensionAttrExistsContext is like ComputerExtensionAttrExists but uses the given context for the request
+func (j *Client) ComputerExtensionAttrExistsContext(ctx context.Context, identifier interface{}) bool {
+	_, err := j.ComputerExtensionAttributeDetailsContext(ctx, identifier)
 	if err != nil {
-		if !strings.Contains(err.Error(), "the server has not found anything matching the request URI") {
-			j.logger.Errorf("did not find computer extension attribute %v due to %s", identifier, err.Error())
+		if !errors.Is(err, ErrNotFound) {
+			j.logger.Error("did not find computer extension attribute", "identifier", identifier, "error", err.Error())
 		}
 		return false
 	}
@@ -30,8 +34,13 @@ func (j *Client) ComputerExtensionAttrExists(identifier interface{}) bool {
 
 // ComputerExtensionAttributes returns all computer extension attributes
 func (j *Client) ComputerExtensionAttributes() ([]ComputerExtensionAttribute, error) {
+	return j.ComputerExtensionAttributesContext(context.Background())
+}
+
+// ComputerExtensionAttributesContext is like ComputerExtensionAttributes but uses the given context for the request
+func (j *Client) ComputerExtensionAttributesContext(ctx context.Context) ([]ComputerExtensionAttribute, error) {
 	ep := fmt.Sprintf("%s/%s", j.Endpoint, computerExtAttrContext)
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building JAMF computer extension attribute query request")
 	}
@@ -45,11 +54,16 @@ func (j *Client) ComputerExtensionAttributes() ([]ComputerExtensionAttribute, er
 
 // ComputerExtensionAttributeDetails returns the details for a specific computer extension attribute given its ID or Name
 func (j *Client) ComputerExtensionAttributeDetails(identifier interface{}) (*ComputerExtensionAttributeDetails, error) {
+	return j.ComputerExtensionAttributeDetailsContext(context.Background(), identifier)
+}
+
+// ComputerExtensionAttributeDetailsContext is like ComputerExtensionAttributeDetails but uses the given context for the request
+func (j *Client) ComputerExtensionAttributeDetailsContext(ctx context.Context, identifier interface{}) (*ComputerExtensionAttributeDetails, error) {
 	ep, err := EndpointBuilder(j.Endpoint, computerExtAttrContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for script: %v", identifier)
 	}
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for computer extension attribute: %v", identifier)
 	}
@@ -64,6 +78,11 @@ func (j *Client) ComputerExtensionAttributeDetails(identifier interface{}) (*Com
 
 // UpdateComputerExtensionAttribue will update a computer extension attribute in Jamf by either ID or Name
 func (j *Client) UpdateComputerExtensionAttribue(identifier interface{}, content *ComputerExtensionAttribute) (*ComputerExtensionAttribute, error) {
+	return j.UpdateComputerExtensionAttribueContext(context.Background(), identifier, content)
+}
+
+// UpdateComputerExtensionAttribueContext is like UpdateComputerExtensionAttribue but uses the given context for the request
+func (j *Client) UpdateComputerExtensionAttribueContext(ctx context.Context, identifier interface{}, content *ComputerExtensionAttribute) (*ComputerExtensionAttribute, error) {
 	ep, err := EndpointBuilder(j.Endpoint, computerExtAttrContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for computer extension attribute: %v", identifier)
@@ -80,7 +99,7 @@ func (j *Client) UpdateComputerExtensionAttribue(identifier interface{}, content
 	}
 
 	body := bytes.NewReader(bodyContent)
-	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF update request for computer extension attribute: %v (%s)", identifier, ep)
 	}
@@ -95,6 +114,11 @@ func (j *Client) UpdateComputerExtensionAttribue(identifier interface{}, content
 
 // CreateComputerExtensionAttribute will create a computer extension attribute in Jamf
 func (j *Client) CreateComputerExtensionAttribute(content *ComputerExtensionAttribute) (*ComputerExtensionAttribute, error) {
+	return j.CreateComputerExtensionAttributeContext(context.Background(), content)
+}
+
+// CreateComputerExtensionAttributeContext is like CreateComputerExtensionAttribute but uses the given context for the request
+func (j *Client) CreateComputerExtensionAttributeContext(ctx context.Context, content *ComputerExtensionAttribute) (*ComputerExtensionAttribute, error) {
 	// -1 denotes the next available ID
 	ep, err := EndpointBuilder(j.Endpoint, computerExtAttrContext, -1)
 	if err != nil {
@@ -120,7 +144,7 @@ func (j *Client) CreateComputerExtensionAttribute(content *ComputerExtensionAttr
 	}
 
 	body := bytes.NewReader(bodyContent)
-	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF creation request for computer extension attribute: %v (%s)", content.Name, ep)
 	}
@@ -135,12 +159,17 @@ func (j *Client) CreateComputerExtensionAttribute(content *ComputerExtensionAttr
 
 // DeleteComputerExtensionAttribute will delete a computer extension attribute by either ID or Name
 func (j *Client) DeleteComputerExtensionAttribute(identifier interface{}) (*ComputerExtensionAttribute, error) {
+	return j.DeleteComputerExtensionAttributeContext(context.Background(), identifier)
+}
+
+// DeleteComputerExtensionAttributeContext is like DeleteComputerExtensionAttribute but uses the given context for the request
+func (j *Client) DeleteComputerExtensionAttributeContext(ctx context.Context, identifier interface{}) (*ComputerExtensionAttribute, error) {
 	ep, err := EndpointBuilder(j.Endpoint, computerExtAttrContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for computer extension attribute: %v", identifier)
 	}
 
-	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF deletion request for computer extension attribute: %v (%s)", identifier, ep)
 	}
@@ -12,8 +12,13 @@ import (
 
 // ComputerGroups represents a list of computer groups in Jamf
 func (j *Client) ComputerGroups() ([]BasicComputerGroupInfo, error) {
+	return j.ComputerGroupsContext(context.Background())
+}
+
+// ComputerGroupsContext is like ComputerGroups but uses the given context for the request
+func (j *Client) ComputerGroupsContext(ctx context.Context) ([]BasicComputerGroupInfo, error) {
 	ep := fmt.Sprintf("%s/%s", j.Endpoint, computerGroupsContext)
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building Jamf computer groups query request")
 	}
@@ -21,42 +26,62 @@ func (j *Client) ComputerGroups() ([]BasicComputerGroupInfo, error) {
 	if err := j.makeAPIrequest(req, &res); err != nil {
 		return nil, errors.Wrapf(err, "unable to query available computer groups from %s", ep)
 	}
-	return res.List, nil
+	return filterBySite(ctx, j, res.List, func(ctx context.Context, item BasicComputerGroupInfo) error {
+		_, err := j.ComputerGroupDetailsContext(ctx, item.ID)
+		return err
+	})
 }
 
 // ComputerGroupDetails returns the details for a specific group given its ID or Name
 func (j *Client) ComputerGroupDetails(identifier any) (*ComputerGroup, error) {
+	return j.ComputerGroupDetailsContext(context.Background(), identifier)
+}
+
+// ComputerGroupDetailsContext is like ComputerGroupDetails but uses the given context for the request
+func (j *Client) ComputerGroupDetailsContext(ctx context.Context, identifier any) (*ComputerGroup, error) {
 	ep, err := EndpointBuilder(j.Endpoint, computerGroupsContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for computer group: %v", identifier)
 	}
 
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for computer group: %v", identifier)
 	}
 
 	res := ComputerGroup{}
 	if err := j.makeAPIrequest(req, &res); err != nil {
-		return nil, errors.Wrapf(err, "unable to query computer group with ID: %d from %s", identifier, ep)
+		return nil, errors.Wrapf(err, "unable to query computer group: %v from %s", identifier, ep)
+	}
+	if err := j.checkSite(res.Info.Site); err != nil {
+		return nil, errors.Wrapf(err, "unable to query computer group: %v from %s", identifier, ep)
 	}
 	return &res, nil
 }
 
 // UpdateComputerGroupMembers will update the members of a computer group in Jamf by either group ID or group Name
 func (j *Client) UpdateComputerGroupMembers(identifier any, updates *ComputerGroupBindingChanges) (*ComputerGroupDetails, error) {
+	return j.UpdateComputerGroupMembersContext(context.Background(), identifier, updates)
+}
+
+// UpdateComputerGroupMembersContext is like UpdateComputerGroupMembers but uses the given context for the request
+func (j *Client) UpdateComputerGroupMembersContext(ctx context.Context, identifier any, updates *ComputerGroupBindingChanges) (*ComputerGroupDetails, error) {
 	ep, err := EndpointBuilder(j.Endpoint, computerGroupsContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for computer group: %v", identifier)
 	}
 
+	if err := checkExistingSite(ctx, j, identifier, j.ComputerGroupDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for computer group: %v (%s)", identifier, ep)
+	}
+
 	bodyContent, err := xml.Marshal(updates)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF update payload for computer group: %v", identifier)
 	}
 
 	body := bytes.NewReader(bodyContent)
-	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF update request for computer group: %v (%s)", identifier, ep)
 	}
@@ -69,7 +94,13 @@ func (j *Client) UpdateComputerGroupMembers(identifier any, updates *ComputerGro
 	return &res, nil
 }
 
+// CreateComputerGroup will create a static or smart computer group in Jamf
 func (j *Client) CreateComputerGroup(newGroup *ComputerGroupDetails) (*ComputerGroupDetails, error) {
+	return j.CreateComputerGroupContext(context.Background(), newGroup)
+}
+
+// CreateComputerGroupContext is like CreateComputerGroup but uses the given context for the request
+func (j *Client) CreateComputerGroupContext(ctx context.Context, newGroup *ComputerGroupDetails) (*ComputerGroupDetails, error) {
 	ep, err := EndpointBuilder(j.Endpoint, computerGroupsContext, -1)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building JAMF add computer group request endpoint")
@@ -79,13 +110,24 @@ func (j *Client) CreateComputerGroup(newGroup *ComputerGroupDetails) (*ComputerG
 		return nil, errors.New("error building JAMF add computer group request: group name is required")
 	}
 
-	bodyContent, err := xml.Marshal(newGroup)
+	if !newGroup.IsSmart && len(newGroup.Criteria) > 0 {
+		return nil, errors.New("error building JAMF add computer group request: criteria can only be set on smart groups")
+	}
+
+	site, err := j.clientSite(newGroup.Site)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF add computer group request")
+	}
+	group := *newGroup
+	group.Site = site
+
+	bodyContent, err := xml.Marshal(&group)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building JAMF add computer group payload")
 	}
 
 	body := bytes.NewReader(bodyContent)
-	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF add computer group request")
 	}
@@ -98,13 +140,23 @@ func (j *Client) CreateComputerGroup(newGroup *ComputerGroupDetails) (*ComputerG
 	return &res, nil
 }
 
+// DeleteComputerGroup will delete a computer group by either ID or Name
 func (j *Client) DeleteComputerGroup(identifier any) (*ComputerGroupDetails, error) {
+	return j.DeleteComputerGroupContext(context.Background(), identifier)
+}
+
+// DeleteComputerGroupContext is like DeleteComputerGroup but uses the given context for the request
+func (j *Client) DeleteComputerGroupContext(ctx context.Context, identifier any) (*ComputerGroupDetails, error) {
 	ep, err := EndpointBuilder(j.Endpoint, computerGroupsContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF delete computer group request endpoint for group: %v", identifier)
 	}
 
-	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err := checkExistingSite(ctx, j, identifier, j.ComputerGroupDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF delete computer group request for group: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF delete computer group request for group: %v", identifier)
 	}
@@ -20,10 +20,13 @@ type BasicComputerGroupInfo struct {
 	IsSmart bool   `json:"is_smart" xml:"is_smart"`
 }
 
-// ComputerGroupDetails represents the detailed information for a specific computer group
+// ComputerGroupDetails represents the detailed information for a specific computer group.
+// Criteria only apply to smart groups while static groups list their members in Computers
 type ComputerGroupDetails struct {
-	XMLName xml.Name `json:"computer_group" xml:"computer_group,omitempty"`
+	XMLName xml.Name `json:"-" xml:"computer_group,omitempty"`
 	BasicComputerGroupInfo
+	Site      *Site               `json:"site,omitempty" xml:"site,omitempty"`
+	Criteria  []Criterion         `json:"criteria,omitempty" xml:"criteria>criterion,omitempty"`
 	Computers []BasicComputerInfo `json:"computers" xml:"computers>computer,omitempty"`
 }
 
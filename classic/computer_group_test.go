@@ -1,12 +1,14 @@
 package classic_test
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	jamf "github.com/DataDog/jamf-api-client-go/classic"
 	"github.com/stretchr/testify/assert"
@@ -102,6 +104,12 @@ func computerGroupsResponseMocks(t *testing.T) *httptest.Server {
 				}
 				fmt.Fprint(w, string(groupData))
 			}
+		case "/api/v1/auth/token":
+			exp := time.Now().Add(time.Minute * 10).Format(time.RFC3339)
+			fmt.Fprintf(w, `{
+				"token": "eyJhbGciOiJIUzI1NiJ9.iam4fAKet3StTok3n",
+				"expires": "%s"
+			}`, exp)
 		default:
 			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
 			return
@@ -174,9 +182,58 @@ func TestDeleteComputerGroup(t *testing.T) {
 	assert.Equal(t, "Test Group 1", deletedGrp.Name)
 	assert.Equal(t, false, deletedGrp.IsSmart)
 
-	deletedGrp, err = j.DeleteComputerGroup("Test%20Group%201")
+	deletedGrp, err = j.DeleteComputerGroup("Test Group 1")
 	assert.Nil(t, err)
 	assert.Equal(t, 1, deletedGrp.ID)
 	assert.Equal(t, "Test Group 1", deletedGrp.Name)
 	assert.Equal(t, false, deletedGrp.IsSmart)
 }
+
+func TestSmartComputerGroupCriteria(t *testing.T) {
+	server := computerGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth())
+	assert.Nil(t, err)
+
+	criteria, err := jamf.NewCriteriaBuilder().
+		Where("Operating System Version", jamf.SearchTypeLike, "14.").
+		AndGroup(jamf.NewCriteriaBuilder().
+			Where("Model", jamf.SearchTypeLike, "MacBook").
+			Or("Model", jamf.SearchTypeLike, "iMac")).
+		Build()
+	assert.Nil(t, err)
+
+	grp := &jamf.ComputerGroupDetails{
+		BasicComputerGroupInfo: jamf.BasicComputerGroupInfo{
+			Name:    "Unit Test Smart Group",
+			IsSmart: true,
+		},
+		Criteria: criteria,
+	}
+
+	// XML round trip
+	data, err := xml.Marshal(grp)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "<criteria><criterion><name>Operating System Version</name><priority>0</priority><and_or>and</and_or><search_type>like</search_type><value>14.</value><opening_paren>false</opening_paren><closing_paren>false</closing_paren></criterion>")
+	xmlGrp := &jamf.ComputerGroupDetails{}
+	assert.Nil(t, xml.Unmarshal(data, xmlGrp))
+	assert.Equal(t, criteria, xmlGrp.Criteria)
+
+	// JSON round trip
+	data, err = json.Marshal(&jamf.ComputerGroup{Info: *grp})
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `{"name":"Model","priority":1,"and_or":"and","search_type":"like","value":"MacBook","opening_paren":true,"closing_paren":false}`)
+	jsonGrp := &jamf.ComputerGroup{}
+	assert.Nil(t, json.Unmarshal(data, jsonGrp))
+	assert.Equal(t, criteria, jsonGrp.Info.Criteria)
+
+	createdGrp, err := j.CreateComputerGroup(grp)
+	assert.Nil(t, err)
+	assert.Equal(t, "Unit Test Smart Group", createdGrp.Name)
+	assert.Equal(t, true, createdGrp.IsSmart)
+	assert.Equal(t, criteria, createdGrp.Criteria)
+
+	grp.IsSmart = false
+	_, err = j.CreateComputerGroup(grp)
+	assert.NotNil(t, err)
+}
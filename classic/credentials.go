@@ -0,0 +1,129 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Credentials holds the username and password used to authenticate against Jamf
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// CredentialsProvider is called by the client whenever it needs to authenticate so
+// credentials can be rotated without building a new client
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (*Credentials, error)
+}
+
+// CredentialsProviderFunc allows a function to be used as a CredentialsProvider
+type CredentialsProviderFunc func(ctx context.Context) (*Credentials, error)
+
+// Credentials calls f(ctx)
+func (f CredentialsProviderFunc) Credentials(ctx context.Context) (*Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials returns a provider that always returns the given username and password
+func StaticCredentials(username string, password string) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (*Credentials, error) {
+		return &Credentials{Username: username, Password: password}, nil
+	})
+}
+
+// EnvCredentials returns a provider that reads the username and password from the
+// given environment variables every time the client authenticates
+func EnvCredentials(usernameVar string, passwordVar string) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (*Credentials, error) {
+		username, password := os.Getenv(usernameVar), os.Getenv(passwordVar)
+		if username == "" || password == "" {
+			return nil, errors.Errorf("environment variables %s and %s must be set", usernameVar, passwordVar)
+		}
+		return &Credentials{Username: username, Password: password}, nil
+	})
+}
+
+// FileCredentials returns a provider that reads the username and password from the given files
+// i.e a mounted Kubernetes secret. The files are read again whenever they change on disk
+func FileCredentials(usernamePath string, passwordPath string) CredentialsProvider {
+	return &fileCredentials{
+		username: &watchedFile{path: usernamePath},
+		password: &watchedFile{path: passwordPath},
+	}
+}
+
+type fileCredentials struct {
+	username *watchedFile
+	password *watchedFile
+}
+
+func (f *fileCredentials) Credentials(ctx context.Context) (*Credentials, error) {
+	username, err := f.username.read()
+	if err != nil {
+		return nil, err
+	}
+	password, err := f.password.read()
+	if err != nil {
+		return nil, err
+	}
+	if username == "" || password == "" {
+		return nil, errors.Errorf("credential files %s and %s must not be empty", f.username.path, f.password.path)
+	}
+	return &Credentials{Username: username, Password: password}, nil
+}
+
+// watchedFile caches the contents of a file until its modification time or size changes
+type watchedFile struct {
+	path    string
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	value   string
+}
+
+func (w *watchedFile) read() (string, error) {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to read credentials file %s", w.path)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if info.ModTime().Equal(w.modTime) && info.Size() == w.size && w.value != "" {
+		return w.value, nil
+	}
+
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to read credentials file %s", w.path)
+	}
+	// secrets are often written with a trailing newline
+	w.value = strings.TrimSpace(string(data))
+	w.modTime, w.size = info.ModTime(), info.Size()
+	return w.value, nil
+}
+
+// credentials returns the credentials from the configured provider or the
+// Username and Password set on the client
+func (j *Client) credentials(ctx context.Context) (*Credentials, error) {
+	if j.provider == nil {
+		return &Credentials{Username: j.Username, Password: j.Password}, nil
+	}
+	creds, err := j.provider.Credentials(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to retrieve Jamf credentials")
+	}
+	if creds == nil {
+		return nil, errors.New("unable to retrieve Jamf credentials: provider returned no credentials")
+	}
+	return creds, nil
+}
@@ -0,0 +1,123 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+// credentialsResponseMocks echoes the basic auth credentials sent with each request
+func credentialsResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		switch r.URL.Path {
+		case "/api/v1/auth/token":
+			exp := time.Now().Add(time.Minute * 10).Format(time.RFC3339)
+			fmt.Fprintf(w, `{"token": "%s:%s", "expires": "%s"}`, username, password, exp)
+		case "/JSSResource/mock/test":
+			fmt.Fprintf(w, `{"status": "%s:%s"}`, username, password)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func credentialsMockStatus(t *testing.T, j *jamf.Client) (string, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/mock/test", j.Endpoint), nil)
+	assert.Nil(t, err)
+	statusResponse := &MockResponse{}
+	_, err = j.MockAPIRequest(req, statusResponse)
+	return statusResponse.Status, err
+}
+
+func TestStaticCredentialsProvider(t *testing.T) {
+	server := credentialsResponseMocks(t)
+	defer server.Close()
+
+	j, err := jamf.NewClient(server.URL, "", "", server.Client(), jamf.WithCredentialsProvider(jamf.StaticCredentials("static-user", "static-password")))
+	assert.Nil(t, err)
+	status, err := credentialsMockStatus(t, j)
+	assert.Nil(t, err)
+	assert.Equal(t, "static-user:static-password", status)
+}
+
+func TestEnvCredentialsProvider(t *testing.T) {
+	server := credentialsResponseMocks(t)
+	defer server.Close()
+
+	t.Setenv("TEST_JAMF_USERNAME", "env-user")
+	t.Setenv("TEST_JAMF_PASSWORD", "env-password")
+	j, err := jamf.NewClient(server.URL, "", "", server.Client(), jamf.WithCredentialsProvider(jamf.EnvCredentials("TEST_JAMF_USERNAME", "TEST_JAMF_PASSWORD")))
+	assert.Nil(t, err)
+	status, err := credentialsMockStatus(t, j)
+	assert.Nil(t, err)
+	assert.Equal(t, "env-user:env-password", status)
+
+	// rotated values are picked up on the next request
+	t.Setenv("TEST_JAMF_PASSWORD", "rotated-env-password")
+	status, err = credentialsMockStatus(t, j)
+	assert.Nil(t, err)
+	assert.Equal(t, "env-user:rotated-env-password", status)
+
+	t.Setenv("TEST_JAMF_PASSWORD", "")
+	_, err = credentialsMockStatus(t, j)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "environment variables TEST_JAMF_USERNAME and TEST_JAMF_PASSWORD must be set")
+}
+
+func TestFileCredentialsProvider(t *testing.T) {
+	server := credentialsResponseMocks(t)
+	defer server.Close()
+
+	dir := t.TempDir()
+	usernamePath, passwordPath := filepath.Join(dir, "username"), filepath.Join(dir, "password")
+	assert.Nil(t, os.WriteFile(usernamePath, []byte("file-user\n"), 0o600))
+	assert.Nil(t, os.WriteFile(passwordPath, []byte("file-password\n"), 0o600))
+
+	j, err := jamf.NewClient(server.URL, "", "", server.Client(), jamf.WithTokenAuth(), jamf.WithCredentialsProvider(jamf.FileCredentials(usernamePath, passwordPath)))
+	assert.Nil(t, err)
+	assert.Nil(t, j.GetAuthToken())
+	assert.Equal(t, "file-user:file-password", j.AuthToken().Token)
+
+	// simulate a secret rotation
+	assert.Nil(t, os.WriteFile(passwordPath, []byte("rotated-file-password\n"), 0o600))
+	future := time.Now().Add(time.Minute)
+	assert.Nil(t, os.Chtimes(passwordPath, future, future))
+	assert.Nil(t, j.GetAuthToken())
+	assert.Equal(t, "file-user:rotated-file-password", j.AuthToken().Token)
+
+	assert.Nil(t, os.Remove(passwordPath))
+	err = j.GetAuthToken()
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestCredentialsProviderFunc(t *testing.T) {
+	server := credentialsResponseMocks(t)
+	defer server.Close()
+
+	provider := jamf.CredentialsProviderFunc(func(ctx context.Context) (*jamf.Credentials, error) {
+		return nil, errors.New("vault is sealed")
+	})
+	j, err := jamf.NewClient(server.URL, "", "", server.Client(), jamf.WithCredentialsProvider(provider))
+	assert.Nil(t, err)
+	_, err = credentialsMockStatus(t, j)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unable to retrieve Jamf credentials: vault is sealed")
+
+	_, err = jamf.NewClient(server.URL, "", "", server.Client(), jamf.WithCredentialsProvider(nil))
+	assert.NotNil(t, err)
+	assert.Equal(t, "credentials provider must not be nil", err.Error())
+}
@@ -0,0 +1,122 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"github.com/pkg/errors"
+)
+
+// CriteriaBuilder builds the criteria of a smart group assigning the priority, join and parentheses of each
+// criterion. Groups are parenthesized, since Jamf can only open or close a single parenthesis on a criterion
+// a group can not start or end with another group
+//
+//	criteria, err := NewCriteriaBuilder().
+//		Where("Operating System Version", SearchTypeLike, "14.").
+//		AndGroup(NewCriteriaBuilder().
+//			Where("Model", SearchTypeLike, "MacBook").
+//			Or("Model", SearchTypeLike, "iMac")).
+//		Build()
+type CriteriaBuilder struct {
+	items []criteriaItem
+}
+
+// criteriaItem is either a single criterion or a parenthesized group of criteria
+type criteriaItem struct {
+	join      AndOr
+	criterion *Criterion
+	group     *CriteriaBuilder
+}
+
+// NewCriteriaBuilder returns an empty criteria builder
+func NewCriteriaBuilder() *CriteriaBuilder {
+	return &CriteriaBuilder{}
+}
+
+// Where adds the first criterion, it is the same as And
+func (b *CriteriaBuilder) Where(name string, searchType SearchType, value string) *CriteriaBuilder {
+	return b.And(name, searchType, value)
+}
+
+// And adds a criterion which must match along with the previous ones
+func (b *CriteriaBuilder) And(name string, searchType SearchType, value string) *CriteriaBuilder {
+	return b.add(CriterionAnd, name, searchType, value)
+}
+
+// Or adds a criterion which matches when either it or the previous ones match
+func (b *CriteriaBuilder) Or(name string, searchType SearchType, value string) *CriteriaBuilder {
+	return b.add(CriterionOr, name, searchType, value)
+}
+
+// AndGroup adds a parenthesized group of criteria which must match along with the previous ones
+func (b *CriteriaBuilder) AndGroup(group *CriteriaBuilder) *CriteriaBuilder {
+	b.items = append(b.items, criteriaItem{join: CriterionAnd, group: group})
+	return b
+}
+
+// OrGroup adds a parenthesized group of criteria which matches when either it or the previous ones match
+func (b *CriteriaBuilder) OrGroup(group *CriteriaBuilder) *CriteriaBuilder {
+	b.items = append(b.items, criteriaItem{join: CriterionOr, group: group})
+	return b
+}
+
+func (b *CriteriaBuilder) add(join AndOr, name string, searchType SearchType, value string) *CriteriaBuilder {
+	b.items = append(b.items, criteriaItem{
+		join:      join,
+		criterion: &Criterion{Name: name, SearchType: searchType, Value: value},
+	})
+	return b
+}
+
+// Build returns the criteria in the order they were added with their priorities set
+func (b *CriteriaBuilder) Build() ([]Criterion, error) {
+	criteria, err := b.flatten(false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range criteria {
+		criteria[i].Priority = i
+	}
+	// the join of the first criterion is not used but Jamf expects and
+	if len(criteria) > 0 {
+		criteria[0].AndOr = CriterionAnd
+	}
+	return criteria, nil
+}
+
+// flatten returns the criteria of the builder wrapping them in parentheses when nested
+func (b *CriteriaBuilder) flatten(nested bool) ([]Criterion, error) {
+	if b == nil || len(b.items) == 0 {
+		return nil, errors.New("smart group criteria must contain at least one criterion")
+	}
+
+	criteria := []Criterion{}
+	for i, item := range b.items {
+		if item.criterion != nil {
+			c := *item.criterion
+			if c.Name == "" || c.SearchType == "" {
+				return nil, errors.Errorf("smart group criterion %d must have a name and search type", len(criteria))
+			}
+			c.AndOr = item.join
+			criteria = append(criteria, c)
+			continue
+		}
+
+		if nested && (i == 0 || i == len(b.items)-1) {
+			return nil, errors.New("a nested group of criteria can not start or end with another group since a criterion can only open or close one parenthesis")
+		}
+
+		group, err := item.group.flatten(true)
+		if err != nil {
+			return nil, err
+		}
+		group[0].AndOr = item.join
+		criteria = append(criteria, group...)
+	}
+
+	if nested {
+		criteria[0].OpeningParen = true
+		criteria[len(criteria)-1].ClosingParen = true
+	}
+	return criteria, nil
+}
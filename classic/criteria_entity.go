@@ -0,0 +1,49 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+// AndOr joins a criterion to the one before it
+type AndOr string
+
+// Criterion joins
+const (
+	CriterionAnd AndOr = "and"
+	CriterionOr  AndOr = "or"
+)
+
+// SearchType is the operator used to compare a criterion's value
+type SearchType string
+
+// Search types supported by smart group criteria, the ones which are available depend on the criterion
+const (
+	SearchTypeIs                 SearchType = "is"
+	SearchTypeIsNot              SearchType = "is not"
+	SearchTypeLike               SearchType = "like"
+	SearchTypeNotLike            SearchType = "not like"
+	SearchTypeHas                SearchType = "has"
+	SearchTypeDoesNotHave        SearchType = "does not have"
+	SearchTypeMatchesRegex       SearchType = "matches regex"
+	SearchTypeDoesNotMatchRegex  SearchType = "does not match regex"
+	SearchTypeMoreThanXDaysAgo   SearchType = "more than x days ago"
+	SearchTypeLessThanXDaysAgo   SearchType = "less than x days ago"
+	SearchTypeBefore             SearchType = "before (yyyy-mm-dd)"
+	SearchTypeAfter              SearchType = "after (yyyy-mm-dd)"
+	SearchTypeGreaterThan        SearchType = "greater than"
+	SearchTypeLessThan           SearchType = "less than"
+	SearchTypeGreaterThanOrEqual SearchType = "greater than or equal"
+	SearchTypeLessThanOrEqual    SearchType = "less than or equal"
+	SearchTypeMemberOf           SearchType = "member of"
+	SearchTypeNotMemberOf        SearchType = "not member of"
+)
+
+// Criterion represents a single criterion of a smart group
+type Criterion struct {
+	Name         string     `json:"name" xml:"name"`
+	Priority     int        `json:"priority" xml:"priority"`
+	AndOr        AndOr      `json:"and_or" xml:"and_or"`
+	SearchType   SearchType `json:"search_type" xml:"search_type"`
+	Value        string     `json:"value" xml:"value"`
+	OpeningParen bool       `json:"opening_paren" xml:"opening_paren"`
+	ClosingParen bool       `json:"closing_paren" xml:"closing_paren"`
+}
@@ -0,0 +1,65 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCriteriaBuilder(t *testing.T) {
+	criteria, err := jamf.NewCriteriaBuilder().
+		Where("Operating System Version", jamf.SearchTypeLike, "14.").
+		AndGroup(jamf.NewCriteriaBuilder().
+			Where("Model", jamf.SearchTypeLike, "MacBook").
+			Or("Model", jamf.SearchTypeLike, "iMac").
+			OrGroup(jamf.NewCriteriaBuilder().
+				Where("Model", jamf.SearchTypeLike, "Mac mini").
+				And("Last Check-in", jamf.SearchTypeLessThanXDaysAgo, "7")).
+			Or("Model", jamf.SearchTypeLike, "Mac Studio")).
+		And("Computer Group", jamf.SearchTypeNotMemberOf, "Excluded").
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.Criterion{
+		{Name: "Operating System Version", Priority: 0, AndOr: jamf.CriterionAnd, SearchType: jamf.SearchTypeLike, Value: "14."},
+		{Name: "Model", Priority: 1, AndOr: jamf.CriterionAnd, SearchType: jamf.SearchTypeLike, Value: "MacBook", OpeningParen: true},
+		{Name: "Model", Priority: 2, AndOr: jamf.CriterionOr, SearchType: jamf.SearchTypeLike, Value: "iMac"},
+		{Name: "Model", Priority: 3, AndOr: jamf.CriterionOr, SearchType: jamf.SearchTypeLike, Value: "Mac mini", OpeningParen: true},
+		{Name: "Last Check-in", Priority: 4, AndOr: jamf.CriterionAnd, SearchType: jamf.SearchTypeLessThanXDaysAgo, Value: "7", ClosingParen: true},
+		{Name: "Model", Priority: 5, AndOr: jamf.CriterionOr, SearchType: jamf.SearchTypeLike, Value: "Mac Studio", ClosingParen: true},
+		{Name: "Computer Group", Priority: 6, AndOr: jamf.CriterionAnd, SearchType: jamf.SearchTypeNotMemberOf, Value: "Excluded"},
+	}, criteria)
+
+	// a group at the top level is parenthesized and the first join is always and
+	criteria, err = jamf.NewCriteriaBuilder().
+		OrGroup(jamf.NewCriteriaBuilder().Where("Model", jamf.SearchTypeLike, "iMac")).
+		Or("Model", jamf.SearchTypeLike, "MacBook").
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.CriterionAnd, criteria[0].AndOr)
+	assert.True(t, criteria[0].OpeningParen)
+	assert.True(t, criteria[0].ClosingParen)
+	assert.Equal(t, jamf.CriterionOr, criteria[1].AndOr)
+}
+
+func TestCriteriaBuilderInvalid(t *testing.T) {
+	_, err := jamf.NewCriteriaBuilder().Build()
+	assert.NotNil(t, err)
+
+	_, err = jamf.NewCriteriaBuilder().Where("", jamf.SearchTypeIs, "value").Build()
+	assert.NotNil(t, err)
+
+	_, err = jamf.NewCriteriaBuilder().AndGroup(jamf.NewCriteriaBuilder()).Build()
+	assert.NotNil(t, err)
+
+	// the first criterion would need to open two parentheses
+	_, err = jamf.NewCriteriaBuilder().
+		Where("Model", jamf.SearchTypeLike, "iMac").
+		AndGroup(jamf.NewCriteriaBuilder().
+			AndGroup(jamf.NewCriteriaBuilder().Where("Model", jamf.SearchTypeLike, "MacBook")).
+			Or("Model", jamf.SearchTypeLike, "Mac mini")).
+		Build()
+	assert.NotNil(t, err)
+}
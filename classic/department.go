@@ -0,0 +1,149 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Departments returns a list of departments available in Jamf
+func (j *Client) Departments() ([]Department, error) {
+	return j.DepartmentsContext(context.Background())
+}
+
+// DepartmentsContext is like Departments but uses the given context for the request
+func (j *Client) DepartmentsContext(ctx context.Context) ([]Department, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, departmentsContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf departments query request")
+	}
+	res := Departments{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available departments from %s", ep)
+	}
+	return res.List, nil
+}
+
+// DepartmentDetails returns the details for a specific department given its ID or Name
+func (j *Client) DepartmentDetails(identifier any) (*DepartmentDetails, error) {
+	return j.DepartmentDetailsContext(context.Background(), identifier)
+}
+
+// DepartmentDetailsContext is like DepartmentDetails but uses the given context for the request
+func (j *Client) DepartmentDetailsContext(ctx context.Context, identifier any) (*DepartmentDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, departmentsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for department: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for department: %v", identifier)
+	}
+
+	res := departmentResponse{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query department: %v from %s", identifier, ep)
+	}
+	return &res.Details, nil
+}
+
+// UpdateDepartment will update a department in Jamf by either ID or Name
+func (j *Client) UpdateDepartment(identifier any, department *DepartmentDetails) (*DepartmentDetails, error) {
+	return j.UpdateDepartmentContext(context.Background(), identifier, department)
+}
+
+// UpdateDepartmentContext is like UpdateDepartment but uses the given context for the request
+func (j *Client) UpdateDepartmentContext(ctx context.Context, identifier any, department *DepartmentDetails) (*DepartmentDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, departmentsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for department: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(department)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for department: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for department: %v (%s)", identifier, ep)
+	}
+
+	res := DepartmentDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for department: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateDepartment will create a department in Jamf
+func (j *Client) CreateDepartment(department *DepartmentDetails) (*DepartmentDetails, error) {
+	return j.CreateDepartmentContext(context.Background(), department)
+}
+
+// CreateDepartmentContext is like CreateDepartment but uses the given context for the request
+func (j *Client) CreateDepartmentContext(ctx context.Context, department *DepartmentDetails) (*DepartmentDetails, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, departmentsContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF query request for new department")
+	}
+
+	if department.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new department"), "unable to process JAMF creation request for department: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(department)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for department: %v", department.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for department: %v (%s)", department.Name, ep)
+	}
+
+	res := DepartmentDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for department: %v (%s)", department.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteDepartment will delete a department by either ID or Name
+func (j *Client) DeleteDepartment(identifier any) (*DepartmentDetails, error) {
+	return j.DeleteDepartmentContext(context.Background(), identifier)
+}
+
+// DeleteDepartmentContext is like DeleteDepartment but uses the given context for the request
+func (j *Client) DeleteDepartmentContext(ctx context.Context, identifier any) (*DepartmentDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, departmentsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for department: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for department: %v (%s)", identifier, ep)
+	}
+
+	res := DepartmentDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for department: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,30 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "encoding/xml"
+
+// Departments represents a list of departments in Jamf
+type Departments struct {
+	List []Department `json:"departments" xml:"department,omitempty"`
+	Size int          `json:"size" xml:"size"`
+}
+
+// DepartmentDetails holds the details of a department configured in Jamf
+type DepartmentDetails struct {
+	XMLName xml.Name `json:"-" xml:"department,omitempty"`
+	ID      int      `json:"id,omitempty" xml:"id,omitempty"`
+	Name    string   `json:"name" xml:"name,omitempty"`
+}
+
+// departmentResponse unwraps the department returned by the classic API
+type departmentResponse struct {
+	Details DepartmentDetails `json:"department"`
+}
+
+// UnmarshalXML decodes the department element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (d *departmentResponse) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&d.Details, &start)
+}
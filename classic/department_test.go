@@ -0,0 +1,103 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var DEPARTMENTS_BASE_API_ENDPOINT = "/JSSResource/departments"
+
+func departmentsResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case DEPARTMENTS_BASE_API_ENDPOINT:
+			fmt.Fprint(w, `{
+				"departments": [
+					{
+						"id": 1,
+						"name": "Engineering"
+					},
+					{
+						"id": 2,
+						"name": "Finance"
+					}]
+				}`)
+		case fmt.Sprintf("%s/id/1", DEPARTMENTS_BASE_API_ENDPOINT), fmt.Sprintf("%s/name/Engineering", DEPARTMENTS_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{"department": {"id": 1, "name": "Engineering"}}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				assert.Equal(t, `<department><name>R&amp;D</name></department>`, string(data))
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><department><id>1</id></department>`)
+			case "DELETE":
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><department><id>1</id></department>`)
+			}
+		case fmt.Sprintf("%s/id/2", DEPARTMENTS_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><department><id>2</id><name>Finance</name></department>`)
+		case fmt.Sprintf("%s/id/-1", DEPARTMENTS_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, `<department><name>Sales</name></department>`, string(data))
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><department><id>3</id></department>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf departments API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestDepartments(t *testing.T) {
+	testServer := departmentsResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	departments, err := j.Departments()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(departments))
+	assert.Equal(t, "Finance", departments[1].Name)
+
+	department, err := j.DepartmentDetails("Engineering")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, department.ID)
+
+	department, err = j.DepartmentDetails(2)
+	assert.Nil(t, err)
+	assert.Equal(t, "Finance", department.Name)
+}
+
+func TestCreateUpdateDeleteDepartment(t *testing.T) {
+	testServer := departmentsResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	_, err = j.CreateDepartment(&jamf.DepartmentDetails{})
+	assert.NotNil(t, err)
+
+	department, err := j.CreateDepartment(&jamf.DepartmentDetails{Name: "Sales"})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, department.ID)
+
+	department, err = j.UpdateDepartment("Engineering", &jamf.DepartmentDetails{Name: "R&D"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, department.ID)
+
+	department, err = j.DeleteDepartment(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, department.ID)
+}
@@ -0,0 +1,172 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Sentinel errors that an *APIError matches via errors.Is based on its status code
+var (
+	ErrNotFound     = errors.New("jamf: resource not found")
+	ErrUnauthorized = errors.New("jamf: unauthorized")
+	ErrConflict     = errors.New("jamf: conflict")
+	ErrRateLimited  = errors.New("jamf: rate limited")
+)
+
+// ErrCategoryNotFound is matched via errors.Is by a *CategoryNotFoundError
+var ErrCategoryNotFound = errors.New("jamf: category not found")
+
+// CategoryNotFoundError is returned when a policy or script references a category which doesn't
+// exist in Jamf and category validation is enabled using WithCategoryValidation
+type CategoryNotFoundError struct {
+	Category string
+}
+
+func (e *CategoryNotFoundError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrCategoryNotFound, e.Category)
+}
+
+// Is allows a CategoryNotFoundError to be compared against ErrCategoryNotFound using errors.Is
+func (e *CategoryNotFoundError) Is(target error) bool {
+	return target == ErrCategoryNotFound
+}
+
+// ErrUnknownLocation is matched via errors.Is by an *UnknownLocationError
+var ErrUnknownLocation = errors.New("jamf: unknown building or department")
+
+// UnknownLocationError is returned by a LocationResolver when a payload references
+// buildings or departments which don't exist in Jamf
+type UnknownLocationError struct {
+	Buildings   []string
+	Departments []string
+}
+
+func (e *UnknownLocationError) Error() string {
+	var unknown []string
+	if len(e.Buildings) > 0 {
+		unknown = append(unknown, fmt.Sprintf("buildings %q", e.Buildings))
+	}
+	if len(e.Departments) > 0 {
+		unknown = append(unknown, fmt.Sprintf("departments %q", e.Departments))
+	}
+	return fmt.Sprintf("%s: %s", ErrUnknownLocation, strings.Join(unknown, ", "))
+}
+
+// Is allows an UnknownLocationError to be compared against ErrUnknownLocation using errors.Is
+func (e *UnknownLocationError) Is(target error) bool {
+	return target == ErrUnknownLocation
+}
+
+// ErrSiteMismatch is matched via errors.Is by a *SiteMismatchError
+var ErrSiteMismatch = errors.New("jamf: site does not match the client's site")
+
+// SiteMismatchError is returned when a client pinned to a site using WithSite is asked to
+// create, read, update or delete an object in a different site
+type SiteMismatchError struct {
+	Site       Site
+	ClientSite Site
+}
+
+func (e *SiteMismatchError) Error() string {
+	return fmt.Sprintf("%s: %s (client site %s)", ErrSiteMismatch, locationLabel(e.Site.ID, e.Site.Name), locationLabel(e.ClientSite.ID, e.ClientSite.Name))
+}
+
+// Is allows a SiteMismatchError to be compared against ErrSiteMismatch using errors.Is
+func (e *SiteMismatchError) Is(target error) bool {
+	return target == ErrSiteMismatch
+}
+
+// Jamf classic API errors are returned as a small HTML status page where the
+// reason for the failure is held in paragraph tags
+var htmlParagraphRegex = regexp.MustCompile(`(?is)<p(?:\s[^>]*)?>(.*?)</p>`)
+
+// APIError is returned when the Jamf API responds with an unsuccessful status code
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Body       []byte
+	Message    string
+}
+
+// newAPIError builds an APIError from the request and the raw response body
+func newAPIError(res *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: res.StatusCode,
+		Body:       body,
+		Message:    parseErrorMessage(body),
+	}
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		e.URL = res.Request.URL.String()
+	}
+	return e
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = string(e.Body)
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("request error: %d %s", e.StatusCode, msg)
+}
+
+// Is allows an APIError to be compared against the sentinel errors using errors.Is
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// parseErrorMessage extracts the human readable error message from a Jamf error response
+// which is either an HTML status page (classic API) or a JSON error body (Jamf Pro API)
+func parseErrorMessage(body []byte) string {
+	var proErr struct {
+		Errors []struct {
+			Code        string `json:"code"`
+			Description string `json:"description"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &proErr); err == nil && len(proErr.Errors) > 0 {
+		msgs := make([]string, 0, len(proErr.Errors))
+		for _, e := range proErr.Errors {
+			if e.Description != "" {
+				msgs = append(msgs, e.Description)
+			} else if e.Code != "" {
+				msgs = append(msgs, e.Code)
+			}
+		}
+		return strings.Join(msgs, "; ")
+	}
+
+	matches := htmlParagraphRegex.FindAllSubmatch(body, -1)
+	if len(matches) == 0 {
+		return strings.TrimSpace(string(body))
+	}
+	msgs := make([]string, 0, len(matches))
+	for _, m := range matches {
+		if msg := strings.TrimSpace(html.UnescapeString(string(m[1]))); msg != "" {
+			msgs = append(msgs, msg)
+		}
+	}
+	return strings.Join(msgs, " ")
+}
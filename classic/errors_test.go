@@ -0,0 +1,115 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func errorResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case "/JSSResource/computers/id/404", "/JSSResource/computerextensionattributes/id/404":
+			w.Header().Set("Content-Type", "text/html;charset=UTF-8")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<html><head><title>Status page</title></head><body style="font-family: sans-serif;">`+
+				`<p style="font-size: 1.2em;font-weight: bold;margin: 1em 0px;">Not Found</p>`+
+				`<p>The server has not found anything matching the request URI</p></body></html>`)
+		case "/JSSResource/computergroups/id/-1":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `<html><body><p>Conflict</p><p>Error: Duplicate name</p></body></html>`)
+		case "/JSSResource/policies":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/api/v1/auth/token":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"httpStatus": 401, "errors": [{"code": "INVALID_CREDENTIALS", "description": "Invalid credentials"}]}`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestAPIErrorNotFound(t *testing.T) {
+	server := errorResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+
+	_, err = j.ComputerDetails(404)
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, jamf.ErrNotFound))
+	assert.False(t, errors.Is(err, jamf.ErrConflict))
+
+	var apiErr *jamf.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "GET", apiErr.Method)
+	assert.Equal(t, fmt.Sprintf("%s/JSSResource/computers/id/404", server.URL), apiErr.URL)
+	assert.Equal(t, "Not Found The server has not found anything matching the request URI", apiErr.Message)
+	assert.Contains(t, string(apiErr.Body), "<title>Status page</title>")
+}
+
+func TestAPIErrorConflict(t *testing.T) {
+	server := errorResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+
+	_, err = j.CreateComputerGroup(&jamf.ComputerGroupDetails{
+		BasicComputerGroupInfo: jamf.BasicComputerGroupInfo{Name: "Duplicate Group"},
+	})
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, jamf.ErrConflict))
+
+	var apiErr *jamf.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "POST", apiErr.Method)
+	assert.Equal(t, "Conflict Error: Duplicate name", apiErr.Message)
+}
+
+func TestAPIErrorRateLimited(t *testing.T) {
+	server := errorResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+
+	_, err = j.Policies()
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, jamf.ErrRateLimited))
+	assert.Contains(t, err.Error(), "request error: 429 Too Many Requests")
+}
+
+func TestAPIErrorUnauthorizedToken(t *testing.T) {
+	server := errorResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth())
+	assert.Nil(t, err)
+
+	err = j.GetAuthToken()
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, jamf.ErrUnauthorized))
+
+	var apiErr *jamf.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Invalid credentials", apiErr.Message)
+
+	_, err = j.Scripts()
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, jamf.ErrUnauthorized))
+}
+
+func TestComputerExtensionAttrExistsNotFound(t *testing.T) {
+	server := errorResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+	assert.False(t, j.ComputerExtensionAttrExists(404))
+}
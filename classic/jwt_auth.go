@@ -10,6 +10,11 @@ type AuthToken struct {
 }
 
 func (t *AuthToken) IsExpired() (bool, error) {
+	return t.ExpiresWithin(0)
+}
+
+// ExpiresWithin reports whether the token is expired or will expire within the given duration
+func (t *AuthToken) ExpiresWithin(d time.Duration) (bool, error) {
 	if t.Expires == "" {
 		return true, nil
 	}
@@ -17,5 +22,5 @@ func (t *AuthToken) IsExpired() (bool, error) {
 	if err != nil {
 		return true, err
 	}
-	return expiration.Before(time.Now()), nil
+	return expiration.Before(time.Now().Add(d)), nil
 }
@@ -0,0 +1,288 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// LocationResolver validates the building and department names used in Scope and ComputerDetails
+// payloads before they are sent since Jamf silently ignores names which don't exist. Buildings and
+// departments are fetched on first use and cached, use Refresh to reload them
+type LocationResolver struct {
+	client *Client
+
+	mu          sync.Mutex
+	buildings   *locationIndex
+	departments *locationIndex
+}
+
+// locationIndex maps the names of buildings or departments to their IDs
+type locationIndex struct {
+	ids   map[string]int
+	names map[int]string
+}
+
+func newLocationIndex() *locationIndex {
+	return &locationIndex{ids: map[string]int{}, names: map[int]string{}}
+}
+
+func (l *locationIndex) add(id int, name string) {
+	l.ids[name] = id
+	l.names[id] = name
+}
+
+// resolve returns the ID and name of an entry referenced by ID, name or both in which case they must match
+func (l *locationIndex) resolve(id int, name string) (int, string, bool) {
+	if name == "" {
+		found, ok := l.names[id]
+		return id, found, ok
+	}
+	found, ok := l.ids[name]
+	if ok && id > 0 && found != id {
+		return id, name, false
+	}
+	return found, name, ok
+}
+
+// NewLocationResolver returns a LocationResolver which looks up buildings and departments using the client
+func (j *Client) NewLocationResolver() *LocationResolver {
+	return &LocationResolver{client: j}
+}
+
+// Refresh reloads the buildings and departments from Jamf
+func (r *LocationResolver) Refresh() error {
+	return r.RefreshContext(context.Background())
+}
+
+// RefreshContext is like Refresh but uses the given context for the requests
+func (r *LocationResolver) RefreshContext(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.refresh(ctx)
+}
+
+func (r *LocationResolver) refresh(ctx context.Context) error {
+	buildings, err := r.client.BuildingsContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to load buildings")
+	}
+	departments, err := r.client.DepartmentsContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to load departments")
+	}
+
+	r.buildings, r.departments = newLocationIndex(), newLocationIndex()
+	for _, b := range buildings {
+		r.buildings.add(b.ID, b.Name)
+	}
+	for _, d := range departments {
+		r.departments.add(d.ID, d.Name)
+	}
+	return nil
+}
+
+// load returns the cached buildings and departments, fetching them on first use
+func (r *LocationResolver) load(ctx context.Context) (*locationIndex, *locationIndex, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.buildings == nil {
+		if err := r.refresh(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	return r.buildings, r.departments, nil
+}
+
+// BuildingID returns the ID of the building with the given name
+func (r *LocationResolver) BuildingID(name string) (int, error) {
+	return r.BuildingIDContext(context.Background(), name)
+}
+
+// BuildingIDContext is like BuildingID but uses the given context for the requests
+func (r *LocationResolver) BuildingIDContext(ctx context.Context, name string) (int, error) {
+	buildings, _, err := r.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	id, ok := buildings.ids[name]
+	if !ok {
+		return 0, &UnknownLocationError{Buildings: []string{name}}
+	}
+	return id, nil
+}
+
+// DepartmentID returns the ID of the department with the given name
+func (r *LocationResolver) DepartmentID(name string) (int, error) {
+	return r.DepartmentIDContext(context.Background(), name)
+}
+
+// DepartmentIDContext is like DepartmentID but uses the given context for the requests
+func (r *LocationResolver) DepartmentIDContext(ctx context.Context, name string) (int, error) {
+	_, departments, err := r.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	id, ok := departments.ids[name]
+	if !ok {
+		return 0, &UnknownLocationError{Departments: []string{name}}
+	}
+	return id, nil
+}
+
+// ValidateScope checks every building and department targeted or excluded by the scope exists and
+// returns an *UnknownLocationError listing the ones that don't. The scope is left untouched
+func (r *LocationResolver) ValidateScope(scope *Scope) error {
+	return r.ValidateScopeContext(context.Background(), scope)
+}
+
+// ValidateScopeContext is like ValidateScope but uses the given context for the requests
+func (r *LocationResolver) ValidateScopeContext(ctx context.Context, scope *Scope) error {
+	_, err := r.ResolveScopeContext(ctx, scope)
+	return err
+}
+
+// ResolveScope validates the scope like ValidateScope and returns a copy of it where the buildings and
+// departments referenced by name only have their ID filled in and the other way around. Buildings and
+// departments referenced by both an ID and a name which don't match are reported as unknown
+func (r *LocationResolver) ResolveScope(scope *Scope) (*Scope, error) {
+	return r.ResolveScopeContext(context.Background(), scope)
+}
+
+// ResolveScopeContext is like ResolveScope but uses the given context for the requests
+func (r *LocationResolver) ResolveScopeContext(ctx context.Context, scope *Scope) (*Scope, error) {
+	if scope == nil {
+		return nil, nil
+	}
+	buildings, departments, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unknown := &UnknownLocationError{}
+	resolved := *scope
+	resolved.Buildings = resolveBuildings(buildings, scope.Buildings, &unknown.Buildings)
+	resolved.Departments = resolveDepartments(departments, scope.Departments, &unknown.Departments)
+	if scope.Exclusions != nil {
+		exclusions := *scope.Exclusions
+		exclusions.Buildings = resolveBuildings(buildings, scope.Exclusions.Buildings, &unknown.Buildings)
+		exclusions.Departments = resolveDepartments(departments, scope.Exclusions.Departments, &unknown.Departments)
+		resolved.Exclusions = &exclusions
+	}
+
+	if len(unknown.Buildings) > 0 || len(unknown.Departments) > 0 {
+		return nil, unknown
+	}
+	return &resolved, nil
+}
+
+// resolveBuildings returns resolved copies of the buildings and appends the ones which don't exist to unknown
+func resolveBuildings(index *locationIndex, buildings []*Building, unknown *[]string) []*Building {
+	if buildings == nil {
+		return nil
+	}
+	resolved := make([]*Building, len(buildings))
+	for i, b := range buildings {
+		if b == nil {
+			continue
+		}
+		id, name, ok := index.resolve(b.ID, b.Name)
+		if !ok {
+			*unknown = append(*unknown, referenceLabel(b.ID, b.Name))
+		}
+		resolved[i] = &Building{ID: id, Name: name}
+	}
+	return resolved
+}
+
+// resolveDepartments returns resolved copies of the departments and appends the ones which don't exist to unknown
+func resolveDepartments(index *locationIndex, departments []*Department, unknown *[]string) []*Department {
+	if departments == nil {
+		return nil
+	}
+	resolved := make([]*Department, len(departments))
+	for i, d := range departments {
+		if d == nil {
+			continue
+		}
+		id, name, ok := index.resolve(d.ID, d.Name)
+		if !ok {
+			*unknown = append(*unknown, referenceLabel(d.ID, d.Name))
+		}
+		resolved[i] = &Department{ID: id, Name: name}
+	}
+	return resolved
+}
+
+// ValidateComputer checks the building and department of the computer's location exist and
+// returns an *UnknownLocationError when they don't. Empty values are not validated
+func (r *LocationResolver) ValidateComputer(details *ComputerDetails) error {
+	return r.ValidateComputerContext(context.Background(), details)
+}
+
+// ValidateComputerContext is like ValidateComputer but uses the given context for the requests
+func (r *LocationResolver) ValidateComputerContext(ctx context.Context, details *ComputerDetails) error {
+	if details == nil || (details.UserLocation.Building == "" && details.UserLocation.Department == "") {
+		return nil
+	}
+	buildings, departments, err := r.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	unknown := &UnknownLocationError{}
+	if name := details.UserLocation.Building; name != "" {
+		if _, ok := buildings.ids[name]; !ok {
+			unknown.Buildings = append(unknown.Buildings, name)
+		}
+	}
+	if name := details.UserLocation.Department; name != "" {
+		if _, ok := departments.ids[name]; !ok {
+			unknown.Departments = append(unknown.Departments, name)
+		}
+	}
+
+	if len(unknown.Buildings) > 0 || len(unknown.Departments) > 0 {
+		return unknown
+	}
+	return nil
+}
+
+// validateScope validates the scope using the client resolver when location validation is enabled using WithLocationValidation
+func (j *Client) validateScope(ctx context.Context, scope *Scope) error {
+	if j.locations == nil {
+		return nil
+	}
+	return j.locations.ValidateScopeContext(ctx, scope)
+}
+
+// validateComputerLocation validates the location of the computer using the client resolver when location
+// validation is enabled using WithLocationValidation
+func (j *Client) validateComputerLocation(ctx context.Context, details *ComputerDetails) error {
+	if j.locations == nil {
+		return nil
+	}
+	return j.locations.ValidateComputerContext(ctx, details)
+}
+
+// referenceLabel identifies a building or department referenced from a scope in an UnknownLocationError
+func referenceLabel(id int, name string) string {
+	if id > 0 && name != "" {
+		return fmt.Sprintf("%s (id:%d)", name, id)
+	}
+	return locationLabel(id, name)
+}
+
+// locationLabel identifies a building or department referenced by ID only in an UnknownLocationError
+func locationLabel(id int, name string) string {
+	if name != "" {
+		return name
+	}
+	return "id:" + strconv.Itoa(id)
+}
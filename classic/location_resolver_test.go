@@ -0,0 +1,179 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func locationResponseMocks(requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		switch r.RequestURI {
+		case BUILDINGS_BASE_API_ENDPOINT:
+			fmt.Fprint(w, `{"buildings": [{"id": 1, "name": "Boston"}, {"id": 2, "name": "New York"}]}`)
+		case DEPARTMENTS_BASE_API_ENDPOINT:
+			fmt.Fprint(w, `{"departments": [{"id": 1, "name": "Engineering"}, {"id": 2, "name": "Finance"}]}`)
+		case "/JSSResource/policies/id/-1":
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><policy><id>10</id></policy>`)
+		case "/JSSResource/policies/id/10":
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><policy><id>10</id></policy>`)
+		case "/JSSResource/computers/id/1":
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><computer><general><id>1</id></general></computer>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestLocationResolver(t *testing.T) {
+	var requests int32
+	testServer := locationResponseMocks(&requests)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	resolver := j.NewLocationResolver()
+	id, err := resolver.BuildingID("New York")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, id)
+
+	id, err = resolver.DepartmentID("Engineering")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, id)
+
+	_, err = resolver.DepartmentID("Enginering")
+	assert.True(t, errors.Is(err, jamf.ErrUnknownLocation))
+
+	// buildings and departments are only fetched once
+	assert.Equal(t, int32(2), requests)
+	assert.Nil(t, resolver.Refresh())
+	assert.Equal(t, int32(4), requests)
+}
+
+func TestLocationResolverValidateScope(t *testing.T) {
+	var requests int32
+	testServer := locationResponseMocks(&requests)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	resolver := j.NewLocationResolver()
+
+	scope := &jamf.Scope{
+		Buildings:   []*jamf.Building{{Name: "Boston"}, {ID: 2}},
+		Departments: []*jamf.Department{{Name: "Finance"}},
+		Exclusions: &jamf.Exclusions{
+			Departments: []*jamf.Department{{Name: "Engineering"}},
+		},
+	}
+	assert.Nil(t, resolver.ValidateScope(scope))
+
+	// the resolved scope is a copy so the caller's scope is left untouched
+	resolved, err := resolver.ResolveScope(scope)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, resolved.Buildings[0].ID)
+	assert.Equal(t, "New York", resolved.Buildings[1].Name)
+	assert.Equal(t, 2, resolved.Departments[0].ID)
+	assert.Equal(t, 1, resolved.Exclusions.Departments[0].ID)
+	assert.Equal(t, 0, scope.Buildings[0].ID)
+	assert.Equal(t, "", scope.Buildings[1].Name)
+	assert.Equal(t, 0, scope.Exclusions.Departments[0].ID)
+
+	err = resolver.ValidateScope(&jamf.Scope{
+		Buildings:   []*jamf.Building{{Name: "Bostn"}, {ID: 9}},
+		Departments: []*jamf.Department{{Name: "Finance"}},
+		Exclusions: &jamf.Exclusions{
+			Departments: []*jamf.Department{{Name: "Sales"}},
+		},
+	})
+	assert.True(t, errors.Is(err, jamf.ErrUnknownLocation))
+	var unknown *jamf.UnknownLocationError
+	assert.True(t, errors.As(err, &unknown))
+	assert.Equal(t, []string{"Bostn", "id:9"}, unknown.Buildings)
+	assert.Equal(t, []string{"Sales"}, unknown.Departments)
+	assert.Equal(t, `jamf: unknown building or department: buildings ["Bostn" "id:9"], departments ["Sales"]`, err.Error())
+
+	// an ID which doesn't match the name is reported rather than overwritten
+	_, err = resolver.ResolveScope(&jamf.Scope{
+		Buildings:   []*jamf.Building{{ID: 1, Name: "Boston"}, {ID: 1, Name: "New York"}},
+		Departments: []*jamf.Department{{ID: 2, Name: "Finance"}},
+	})
+	assert.True(t, errors.As(err, &unknown))
+	assert.Equal(t, []string{"New York (id:1)"}, unknown.Buildings)
+	assert.Empty(t, unknown.Departments)
+}
+
+func TestLocationResolverValidateComputer(t *testing.T) {
+	var requests int32
+	testServer := locationResponseMocks(&requests)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	resolver := j.NewLocationResolver()
+
+	// nothing to validate so buildings and departments aren't fetched
+	assert.Nil(t, resolver.ValidateComputer(&jamf.ComputerDetails{General: jamf.GeneralInformation{Name: "Updated_Computer"}}))
+	assert.Equal(t, int32(0), requests)
+
+	assert.Nil(t, resolver.ValidateComputer(&jamf.ComputerDetails{
+		UserLocation: jamf.LocationInformation{Building: "Boston", Department: "Engineering"},
+	}))
+
+	err = resolver.ValidateComputer(&jamf.ComputerDetails{
+		UserLocation: jamf.LocationInformation{Building: "Boston", Department: "engineering"},
+	})
+	var unknown *jamf.UnknownLocationError
+	assert.True(t, errors.As(err, &unknown))
+	assert.Empty(t, unknown.Buildings)
+	assert.Equal(t, []string{"engineering"}, unknown.Departments)
+}
+
+func TestLocationValidation(t *testing.T) {
+	var requests int32
+	testServer := locationResponseMocks(&requests)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithLocationValidation())
+	assert.Nil(t, err)
+
+	policy := &jamf.PolicyContents{
+		General: &jamf.PolicyGeneral{Name: "Install Zoom"},
+		Scope:   &jamf.Scope{Buildings: []*jamf.Building{{Name: "Boston"}}},
+	}
+	_, err = j.CreatePolicy(policy)
+	assert.Nil(t, err)
+	// the policy scope is validated without being modified
+	assert.Equal(t, 0, policy.Scope.Buildings[0].ID)
+
+	_, err = j.UpdatePolicy(10, &jamf.PolicyContents{
+		General: &jamf.PolicyGeneral{Name: "Install Zoom"},
+		Scope:   &jamf.Scope{Departments: []*jamf.Department{{Name: "Finanse"}}},
+	})
+	var unknown *jamf.UnknownLocationError
+	assert.True(t, errors.As(err, &unknown))
+	assert.Equal(t, []string{"Finanse"}, unknown.Departments)
+
+	_, err = j.UpdateComputer(&jamf.ComputerIdentifier{ID: "1"}, &jamf.ComputerDetails{
+		UserLocation: jamf.LocationInformation{Building: "New York", Department: "Engineering"},
+	})
+	assert.Nil(t, err)
+
+	_, err = j.UpdateComputer(&jamf.ComputerIdentifier{ID: "1"}, &jamf.ComputerDetails{
+		UserLocation: jamf.LocationInformation{Building: "Chicago"},
+	})
+	assert.True(t, errors.Is(err, jamf.ErrUnknownLocation))
+
+	// buildings and departments are fetched once and invalid requests never reach Jamf
+	assert.Equal(t, int32(4), requests)
+}
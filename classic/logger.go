@@ -4,7 +4,11 @@
 package classic
 
 import (
+	"log/slog"
+	"net/http"
+	"net/url"
 	"os"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -29,3 +33,87 @@ func CreateTextLogger() *logrus.Logger {
 		Level: logrus.InfoLevel,
 	}
 }
+
+// Logger is the structured logger used by the client, *slog.Logger satisfies this interface.
+// Arguments are alternating keys and values as with log/slog
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// discardLogger is used when no logger has been configured
+func discardLogger() Logger {
+	return slog.New(slog.DiscardHandler)
+}
+
+// LogrusLogger adapts a logrus logger i.e one created with CreateJSONLogger to the Logger interface
+func LogrusLogger(l *logrus.Logger) Logger {
+	return &logrusLogger{l}
+}
+
+type logrusLogger struct {
+	l *logrus.Logger
+}
+
+func (l *logrusLogger) entry(args []any) *logrus.Entry {
+	fields := logrus.Fields{}
+	for i := 0; i < len(args); i++ {
+		key, ok := args[i].(string)
+		if !ok || i+1 == len(args) {
+			fields["!BADKEY"] = args[i]
+			continue
+		}
+		fields[key] = args[i+1]
+		i++
+	}
+	return l.l.WithFields(fields)
+}
+
+func (l *logrusLogger) Debug(msg string, args ...any) { l.entry(args).Debug(msg) }
+func (l *logrusLogger) Info(msg string, args ...any)  { l.entry(args).Info(msg) }
+func (l *logrusLogger) Warn(msg string, args ...any)  { l.entry(args).Warn(msg) }
+func (l *logrusLogger) Error(msg string, args ...any) { l.entry(args).Error(msg) }
+
+// sensitiveQueryParams are never logged in clear text
+var sensitiveQueryParams = []string{"token", "access_token", "password", "client_secret"}
+
+// redactURL strips credentials from a URL before it is logged
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	redacted := *u
+	if redacted.User != nil {
+		redacted.User = url.User("REDACTED")
+	}
+	if redacted.RawQuery != "" {
+		q := redacted.Query()
+		for _, p := range sensitiveQueryParams {
+			if q.Has(p) {
+				q.Set(p, "REDACTED")
+			}
+		}
+		redacted.RawQuery = q.Encode()
+	}
+	return redacted.String()
+}
+
+// logRequest logs the outcome of a single HTTP call made to Jamf
+func (j *Client) logRequest(r *http.Request, res *http.Response, err error, attempt int, duration time.Duration) {
+	args := []any{
+		"method", r.Method,
+		"url", redactURL(r.URL),
+		"attempt", attempt,
+		"duration", duration,
+	}
+	switch {
+	case err != nil:
+		j.logger.Error("Jamf request failed", append(args, "error", err.Error())...)
+	case res.StatusCode >= 400:
+		j.logger.Warn("Jamf request unsuccessful", append(args, "status", res.StatusCode)...)
+	default:
+		j.logger.Debug("Jamf request completed", append(args, "status", res.StatusCode)...)
+	}
+}
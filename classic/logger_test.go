@@ -0,0 +1,125 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func loggerResponseMocks(t *testing.T) *httptest.Server {
+	failures := 1
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/token":
+			exp := time.Now().Add(time.Minute * 10).Format(time.RFC3339)
+			fmt.Fprintf(w, `{"token": "super-secret-token", "expires": "%s"}`, exp)
+		case "/JSSResource/scripts":
+			if failures > 0 {
+				failures--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			fmt.Fprint(w, `{"scripts": [{"id": 1, "name": "Logged Script"}]}`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func decodeLogLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		entry := map[string]any{}
+		assert.Nil(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestWithLogHandler(t *testing.T) {
+	server := loggerResponseMocks(t)
+	defer server.Close()
+
+	buf := &bytes.Buffer{}
+	handler := slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	policy := &jamf.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	j, err := jamf.NewClient(server.URL, "log-user", "log-password", server.Client(), jamf.WithTokenAuth(), jamf.WithRetryPolicy(policy), jamf.WithLogHandler(handler))
+	assert.Nil(t, err)
+
+	scripts, err := j.Scripts()
+	assert.Nil(t, err)
+	assert.Equal(t, "Logged Script", scripts[0].Name)
+
+	output := buf.String()
+	assert.NotContains(t, output, "super-secret-token")
+	assert.NotContains(t, output, "log-password")
+
+	lines := decodeLogLines(t, buf)
+	assert.Equal(t, 4, len(lines))
+
+	assert.Equal(t, "Jamf request completed", lines[0]["msg"])
+	assert.Equal(t, "POST", lines[0]["method"])
+	assert.Equal(t, fmt.Sprintf("%s/api/v1/auth/token", server.URL), lines[0]["url"])
+
+	assert.Equal(t, "Jamf request unsuccessful", lines[1]["msg"])
+	assert.Equal(t, "WARN", lines[1]["level"])
+	assert.Equal(t, "GET", lines[1]["method"])
+	assert.Equal(t, float64(http.StatusServiceUnavailable), lines[1]["status"])
+	assert.Equal(t, float64(1), lines[1]["attempt"])
+	assert.Contains(t, lines[1], "duration")
+
+	assert.Equal(t, "retrying Jamf request", lines[2]["msg"])
+	assert.Contains(t, lines[2], "wait")
+
+	assert.Equal(t, "Jamf request completed", lines[3]["msg"])
+	assert.Equal(t, "DEBUG", lines[3]["level"])
+	assert.Equal(t, fmt.Sprintf("%s/JSSResource/scripts", server.URL), lines[3]["url"])
+	assert.Equal(t, float64(http.StatusOK), lines[3]["status"])
+	assert.Equal(t, float64(2), lines[3]["attempt"])
+}
+
+func TestWithLogrusLogger(t *testing.T) {
+	server := loggerResponseMocks(t)
+	defer server.Close()
+
+	buf := &bytes.Buffer{}
+	logger := jamf.CreateJSONLogger()
+	logger.Out = buf
+	j, err := jamf.NewClient(server.URL, "log-user", "log-password", server.Client(), jamf.WithLogger(jamf.LogrusLogger(logger)))
+	assert.Nil(t, err)
+
+	_, err = j.Scripts()
+	assert.NotNil(t, err)
+
+	lines := decodeLogLines(t, buf)
+	assert.Equal(t, 1, len(lines))
+	assert.Equal(t, "Jamf request unsuccessful", lines[0]["msg"])
+	assert.Equal(t, "warning", lines[0]["level"])
+	assert.Equal(t, float64(http.StatusServiceUnavailable), lines[0]["status"])
+	assert.NotContains(t, buf.String(), "log-password")
+}
+
+func TestComputerExtensionAttrExistsWithoutLogger(t *testing.T) {
+	server := loggerResponseMocks(t)
+	defer server.Close()
+
+	// the default logger discards output rather than panicking
+	j, err := jamf.NewClient(server.URL, "log-user", "log-password", server.Client())
+	assert.Nil(t, err)
+	assert.False(t, j.ComputerExtensionAttrExists(1))
+
+	_, err = jamf.NewClient(server.URL, "log-user", "log-password", server.Client(), jamf.WithLogger(nil))
+	assert.NotNil(t, err)
+	assert.Equal(t, "logger must not be nil", err.Error())
+}
@@ -0,0 +1,31 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "net/http"
+
+// Doer sends an HTTP request and returns its response, *http.Client implements Doer
+type Doer interface {
+	Do(r *http.Request) (*http.Response, error)
+}
+
+// DoerFunc allows a function to be used as a Doer
+type DoerFunc func(r *http.Request) (*http.Response, error)
+
+// Do calls f(r)
+func (f DoerFunc) Do(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// Middleware wraps every HTTP call the client makes to Jamf, including token requests,
+// i.e to add auditing, header injection, metrics or fault injection
+type Middleware func(next Doer) Doer
+
+// chainMiddleware wraps the doer so the first middleware is the outermost one
+func chainMiddleware(d Doer, middleware []Middleware) Doer {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		d = middleware[i](d)
+	}
+	return d
+}
@@ -0,0 +1,115 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func middlewareResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/token":
+			exp := time.Now().Add(time.Minute * 10).Format(time.RFC3339)
+			fmt.Fprintf(w, `{"token": "middleware-token", "expires": "%s"}`, exp)
+		case "/JSSResource/mock/test":
+			fmt.Fprintf(w, `{"status": "%s"}`, r.Header.Get("X-Request-Source"))
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestMiddlewareChain(t *testing.T) {
+	server := middlewareResponseMocks(t)
+	defer server.Close()
+
+	var (
+		mu    sync.Mutex
+		audit []string
+	)
+	record := func(entry string) {
+		mu.Lock()
+		defer mu.Unlock()
+		audit = append(audit, entry)
+	}
+	auditing := func(name string) jamf.Middleware {
+		return func(next jamf.Doer) jamf.Doer {
+			return jamf.DoerFunc(func(r *http.Request) (*http.Response, error) {
+				record(fmt.Sprintf("%s > %s %s", name, r.Method, r.URL.Path))
+				res, err := next.Do(r)
+				if err == nil {
+					record(fmt.Sprintf("%s < %d", name, res.StatusCode))
+				}
+				return res, err
+			})
+		}
+	}
+	headers := func(next jamf.Doer) jamf.Doer {
+		return jamf.DoerFunc(func(r *http.Request) (*http.Response, error) {
+			r.Header.Set("X-Request-Source", "middleware-test")
+			return next.Do(r)
+		})
+	}
+
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth(), jamf.WithMiddleware(auditing("outer"), auditing("inner"), headers))
+	assert.Nil(t, err)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/mock/test", j.Endpoint), nil)
+	assert.Nil(t, err)
+	statusResponse := &MockResponse{}
+	_, err = j.MockAPIRequest(req, statusResponse)
+	assert.Nil(t, err)
+	assert.Equal(t, "middleware-test", statusResponse.Status)
+
+	assert.Equal(t, []string{
+		"outer > POST /api/v1/auth/token",
+		"inner > POST /api/v1/auth/token",
+		"inner < 200",
+		"outer < 200",
+		"outer > GET /JSSResource/mock/test",
+		"inner > GET /JSSResource/mock/test",
+		"inner < 200",
+		"outer < 200",
+	}, audit)
+}
+
+func TestMiddlewareFaultInjection(t *testing.T) {
+	server := middlewareResponseMocks(t)
+	defer server.Close()
+
+	failures := 2
+	faults := func(next jamf.Doer) jamf.Doer {
+		return jamf.DoerFunc(func(r *http.Request) (*http.Response, error) {
+			if failures > 0 {
+				failures--
+				rec := httptest.NewRecorder()
+				rec.WriteHeader(http.StatusServiceUnavailable)
+				return rec.Result(), nil
+			}
+			return next.Do(r)
+		})
+	}
+
+	policy := &jamf.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithMiddleware(faults), jamf.WithRetryPolicy(policy))
+	assert.Nil(t, err)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/mock/test", j.Endpoint), nil)
+	assert.Nil(t, err)
+	_, err = j.MockAPIRequest(req, &MockResponse{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, failures)
+
+	_, err = jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithMiddleware(nil))
+	assert.NotNil(t, err)
+	assert.Equal(t, "middleware must not be nil", err.Error())
+}
@@ -0,0 +1,274 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MobileDeviceSection is a section of a mobile device's inventory which can be requested using GetMobileDeviceSubset
+type MobileDeviceSection string
+
+// Sections of a mobile device's inventory
+const (
+	MobileDeviceSectionGeneral               MobileDeviceSection = "General"
+	MobileDeviceSectionLocation              MobileDeviceSection = "Location"
+	MobileDeviceSectionPurchasing            MobileDeviceSection = "Purchasing"
+	MobileDeviceSectionApplications          MobileDeviceSection = "Applications"
+	MobileDeviceSectionSecurity              MobileDeviceSection = "Security"
+	MobileDeviceSectionNetwork               MobileDeviceSection = "Network"
+	MobileDeviceSectionCertificates          MobileDeviceSection = "Certificates"
+	MobileDeviceSectionConfigurationProfiles MobileDeviceSection = "ConfigurationProfiles"
+	MobileDeviceSectionProvisioningProfiles  MobileDeviceSection = "ProvisioningProfiles"
+	MobileDeviceSectionGroups                MobileDeviceSection = "MobileDeviceGroups"
+	MobileDeviceSectionExtensionAttributes   MobileDeviceSection = "ExtensionAttributes"
+)
+
+// MobileDeviceIdentifier include the searchable mobile device identifiers
+type MobileDeviceIdentifier struct {
+	ID           string
+	Name         string
+	UDID         string
+	SerialNumber string
+	MACAddress   string
+}
+
+func (identifier *MobileDeviceIdentifier) endpoint(endpoint string, context string) (string, error) {
+	var (
+		entity string
+		param  string
+	)
+	switch {
+	case identifier == nil:
+	case identifier.ID != "":
+		entity, param = "id", identifier.ID
+	case identifier.Name != "":
+		entity, param = "name", identifier.Name
+	case identifier.UDID != "":
+		entity, param = "udid", identifier.UDID
+	case identifier.SerialNumber != "":
+		entity, param = "serialnumber", identifier.SerialNumber
+	case identifier.MACAddress != "":
+		entity, param = "macaddress", identifier.MACAddress
+	}
+	if param == "" {
+		return "", errors.New("a mobile device ID, name, UDID, serial number or MAC address is required")
+	}
+	// names may contain characters such as # which would otherwise be treated as part of the URL
+	return fmt.Sprintf("%s/%s/%s/%s", endpoint, context, entity, url.PathEscape(param)), nil
+}
+
+// MobileDevices returns all enrolled mobile devices
+func (j *Client) MobileDevices() ([]BasicMobileDeviceInfo, error) {
+	return j.MobileDevicesContext(context.Background())
+}
+
+// MobileDevicesContext is like MobileDevices but uses the given context for the request
+func (j *Client) MobileDevicesContext(ctx context.Context) ([]BasicMobileDeviceInfo, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, mobileDevicesContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF mobile device query request")
+	}
+
+	res := MobileDevices{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query enrolled mobile devices from %s", ep)
+	}
+	return res.List, nil
+}
+
+// GetMobileDevice takes in a search option and returns the details for a specific mobile device
+func (j *Client) GetMobileDevice(identifier *MobileDeviceIdentifier) (*MobileDevice, error) {
+	return j.GetMobileDeviceContext(context.Background(), identifier)
+}
+
+// GetMobileDeviceContext is like GetMobileDevice but uses the given context for the request
+func (j *Client) GetMobileDeviceContext(ctx context.Context, identifier *MobileDeviceIdentifier) (*MobileDevice, error) {
+	ep, err := identifier.endpoint(j.Endpoint, mobileDevicesContext)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF mobile device request endpoint")
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF mobile device request for mobile device: %s", ep)
+	}
+
+	res := MobileDevice{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query enrolled mobile device for mobile device: %s", ep)
+	}
+	return &res, nil
+}
+
+// GetMobileDeviceSubset returns only the requested sections of a specific mobile device
+func (j *Client) GetMobileDeviceSubset(identifier *MobileDeviceIdentifier, sections ...MobileDeviceSection) (*MobileDevice, error) {
+	return j.GetMobileDeviceSubsetContext(context.Background(), identifier, sections...)
+}
+
+// GetMobileDeviceSubsetContext is like GetMobileDeviceSubset but uses the given context for the request
+func (j *Client) GetMobileDeviceSubsetContext(ctx context.Context, identifier *MobileDeviceIdentifier, sections ...MobileDeviceSection) (*MobileDevice, error) {
+	if len(sections) == 0 {
+		return nil, errors.New("error building JAMF mobile device subset request: at least one section is required")
+	}
+
+	subset := make([]string, len(sections))
+	for i, s := range sections {
+		subset[i] = string(s)
+	}
+
+	ep, err := identifier.endpoint(j.Endpoint, mobileDevicesContext)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF mobile device subset request endpoint")
+	}
+	ep = fmt.Sprintf("%s/subset/%s", ep, strings.Join(subset, "&"))
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF mobile device subset request for mobile device: %s", ep)
+	}
+
+	res := MobileDevice{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query enrolled mobile device subset for mobile device: %s", ep)
+	}
+	return &res, nil
+}
+
+// mobileDeviceUpdate is the update payload containing only the sections of a mobile device which can be
+// changed so inventory reported by the device itself is never sent back to Jamf
+type mobileDeviceUpdate struct {
+	XMLName             xml.Name                  `xml:"mobile_device"`
+	General             mobileDeviceGeneralUpdate `xml:"general"`
+	Location            MobileDeviceLocation      `xml:"location"`
+	Purchasing          MobileDevicePurchasing    `xml:"purchasing"`
+	ExtensionAttributes []ExtensionAttribute      `xml:"extension_attributes>extension_attribute,omitempty"`
+}
+
+// mobileDeviceGeneralUpdate holds the general information of a mobile device which can be changed
+type mobileDeviceGeneralUpdate struct {
+	DisplayName string `xml:"display_name,omitempty"`
+	DeviceName  string `xml:"device_name,omitempty"`
+	Name        string `xml:"name,omitempty"`
+	AssetTag    string `xml:"asset_tag,omitempty"`
+	PhoneNumber string `xml:"phone_number,omitempty"`
+	Site        *Site  `xml:"site,omitempty"`
+}
+
+func newMobileDeviceUpdate(details *MobileDeviceDetails) *mobileDeviceUpdate {
+	return &mobileDeviceUpdate{
+		General: mobileDeviceGeneralUpdate{
+			DisplayName: details.General.DisplayName,
+			DeviceName:  details.General.DeviceName,
+			Name:        details.General.Name,
+			AssetTag:    details.General.AssetTag,
+			PhoneNumber: details.General.PhoneNumber,
+			Site:        details.General.Site,
+		},
+		Location:            details.Location,
+		Purchasing:          details.Purchasing,
+		ExtensionAttributes: details.ExtensionAttributes,
+	}
+}
+
+// UpdateMobileDevice takes in an identifier and updated content and updates the device on the server.
+// Only the general, location, purchasing and extension attribute sections can be updated
+func (j *Client) UpdateMobileDevice(identifier *MobileDeviceIdentifier, updates *MobileDeviceDetails) (*MobileDeviceDetails, error) {
+	return j.UpdateMobileDeviceContext(context.Background(), identifier, updates)
+}
+
+// UpdateMobileDeviceContext is like UpdateMobileDevice but uses the given context for the request
+func (j *Client) UpdateMobileDeviceContext(ctx context.Context, identifier *MobileDeviceIdentifier, updates *MobileDeviceDetails) (*MobileDeviceDetails, error) {
+	ep, err := identifier.endpoint(j.Endpoint, mobileDevicesContext)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF update request endpoint for mobile device")
+	}
+	content, err := xml.Marshal(newMobileDeviceUpdate(updates))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for mobile device: %v", identifier)
+	}
+
+	body := bytes.NewReader(content)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for mobile device: %v (%s)", identifier, ep)
+	}
+
+	res := MobileDeviceDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for mobile device: %v (%s)", identifier, ep)
+	}
+	return &res, nil
+}
+
+// mobileDeviceExtensionAttributes is the update payload containing only extension attributes
+// so the rest of the device's inventory is left untouched
+type mobileDeviceExtensionAttributes struct {
+	XMLName             xml.Name             `xml:"mobile_device"`
+	ExtensionAttributes []ExtensionAttribute `xml:"extension_attributes>extension_attribute"`
+}
+
+// UpdateMobileDeviceExtensionAttributes sets the value of the given extension attributes on a mobile device,
+// extension attributes are matched by ID or name
+func (j *Client) UpdateMobileDeviceExtensionAttributes(identifier *MobileDeviceIdentifier, attributes []ExtensionAttribute) (*MobileDeviceDetails, error) {
+	return j.UpdateMobileDeviceExtensionAttributesContext(context.Background(), identifier, attributes)
+}
+
+// UpdateMobileDeviceExtensionAttributesContext is like UpdateMobileDeviceExtensionAttributes but uses the given context for the request
+func (j *Client) UpdateMobileDeviceExtensionAttributesContext(ctx context.Context, identifier *MobileDeviceIdentifier, attributes []ExtensionAttribute) (*MobileDeviceDetails, error) {
+	if len(attributes) == 0 {
+		return nil, errors.New("error building JAMF mobile device extension attribute update: at least one extension attribute is required")
+	}
+
+	ep, err := identifier.endpoint(j.Endpoint, mobileDevicesContext)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF extension attribute update request endpoint for mobile device")
+	}
+	content, err := xml.Marshal(&mobileDeviceExtensionAttributes{ExtensionAttributes: attributes})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF extension attribute update payload for mobile device: %v", identifier)
+	}
+
+	body := bytes.NewReader(content)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF extension attribute update request for mobile device: %v (%s)", identifier, ep)
+	}
+
+	res := MobileDeviceDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF extension attribute update request for mobile device: %v (%s)", identifier, ep)
+	}
+	return &res, nil
+}
+
+// DeleteMobileDevice removes a mobile device from Jamf
+func (j *Client) DeleteMobileDevice(identifier *MobileDeviceIdentifier) (*MobileDeviceDetails, error) {
+	return j.DeleteMobileDeviceContext(context.Background(), identifier)
+}
+
+// DeleteMobileDeviceContext is like DeleteMobileDevice but uses the given context for the request
+func (j *Client) DeleteMobileDeviceContext(ctx context.Context, identifier *MobileDeviceIdentifier) (*MobileDeviceDetails, error) {
+	ep, err := identifier.endpoint(j.Endpoint, mobileDevicesContext)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF delete request endpoint for mobile device")
+	}
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF delete request for mobile device: %v (%s)", identifier, ep)
+	}
+
+	res := MobileDeviceDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF delete request for mobile device: %v (%s)", identifier, ep)
+	}
+	return &res, nil
+}
@@ -0,0 +1,183 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// MobileDeviceConfigurationProfiles returns a list of mobile device configuration profiles available in Jamf
+func (j *Client) MobileDeviceConfigurationProfiles() ([]BasicMobileDeviceConfigurationProfileInfo, error) {
+	return j.MobileDeviceConfigurationProfilesContext(context.Background())
+}
+
+// MobileDeviceConfigurationProfilesContext is like MobileDeviceConfigurationProfiles but uses the given context for the request
+func (j *Client) MobileDeviceConfigurationProfilesContext(ctx context.Context) ([]BasicMobileDeviceConfigurationProfileInfo, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, mobileDeviceConfigurationProfilesContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf mobile device configuration profiles query request")
+	}
+	res := MobileDeviceConfigurationProfiles{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available mobile device configuration profiles from %s", ep)
+	}
+	return filterBySite(ctx, j, res.List, func(ctx context.Context, item BasicMobileDeviceConfigurationProfileInfo) error {
+		_, err := j.MobileDeviceConfigurationProfileDetailsContext(ctx, item.ID)
+		return err
+	})
+}
+
+// MobileDeviceConfigurationProfileDetails returns the details for a specific mobile device configuration profile given its ID or Name
+func (j *Client) MobileDeviceConfigurationProfileDetails(identifier any) (*MobileDeviceConfigurationProfile, error) {
+	return j.MobileDeviceConfigurationProfileDetailsContext(context.Background(), identifier)
+}
+
+// MobileDeviceConfigurationProfileDetailsContext is like MobileDeviceConfigurationProfileDetails but uses the given context for the request
+func (j *Client) MobileDeviceConfigurationProfileDetailsContext(ctx context.Context, identifier any) (*MobileDeviceConfigurationProfile, error) {
+	ep, err := EndpointBuilder(j.Endpoint, mobileDeviceConfigurationProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for mobile device configuration profile: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for mobile device configuration profile: %v", identifier)
+	}
+
+	res := MobileDeviceConfigurationProfile{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile device configuration profile: %v from %s", identifier, ep)
+	}
+	var site *Site
+	if res.Content.General != nil {
+		site = res.Content.General.Site
+	}
+	if err := j.checkSite(site); err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile device configuration profile: %v from %s", identifier, ep)
+	}
+	return &res, nil
+}
+
+// UpdateMobileDeviceConfigurationProfile will update a mobile device configuration profile in Jamf by either ID or Name
+func (j *Client) UpdateMobileDeviceConfigurationProfile(identifier any, profile *MobileDeviceConfigurationProfileContents) (*MobileDeviceConfigurationProfileContents, error) {
+	return j.UpdateMobileDeviceConfigurationProfileContext(context.Background(), identifier, profile)
+}
+
+// UpdateMobileDeviceConfigurationProfileContext is like UpdateMobileDeviceConfigurationProfile but uses the given context for the request
+func (j *Client) UpdateMobileDeviceConfigurationProfileContext(ctx context.Context, identifier any, profile *MobileDeviceConfigurationProfileContents) (*MobileDeviceConfigurationProfileContents, error) {
+	ep, err := EndpointBuilder(j.Endpoint, mobileDeviceConfigurationProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for mobile device configuration profile: %v", identifier)
+	}
+
+	if err := checkExistingSite(ctx, j, identifier, j.MobileDeviceConfigurationProfileDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for mobile device configuration profile: %v (%s)", identifier, ep)
+	}
+	// profiles can't be moved out of the site the client is pinned to
+	if profile.General != nil {
+		if _, err := j.clientSite(profile.General.Site); err != nil {
+			return nil, errors.Wrapf(err, "unable to process JAMF update request for mobile device configuration profile: %v (%s)", identifier, ep)
+		}
+	}
+
+	bodyContent, err := xml.Marshal(profile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for mobile device configuration profile: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for mobile device configuration profile: %v (%s)", identifier, ep)
+	}
+
+	res := MobileDeviceConfigurationProfileContents{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for mobile device configuration profile: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateMobileDeviceConfigurationProfile will create a mobile device configuration profile in Jamf
+func (j *Client) CreateMobileDeviceConfigurationProfile(profile *MobileDeviceConfigurationProfileContents) (*MobileDeviceConfigurationProfileContents, error) {
+	return j.CreateMobileDeviceConfigurationProfileContext(context.Background(), profile)
+}
+
+// CreateMobileDeviceConfigurationProfileContext is like CreateMobileDeviceConfigurationProfile but uses the given context for the request
+func (j *Client) CreateMobileDeviceConfigurationProfileContext(ctx context.Context, profile *MobileDeviceConfigurationProfileContents) (*MobileDeviceConfigurationProfileContents, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, mobileDeviceConfigurationProfilesContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF query request for new mobile device configuration profile")
+	}
+
+	if profile.General == nil || profile.General.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new mobile device configuration profile"), "unable to process JAMF creation request for mobile device configuration profile: (%s)", ep)
+	}
+
+	site, err := j.clientSite(profile.General.Site)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for mobile device configuration profile: %v (%s)", profile.General.Name, ep)
+	}
+	general := *profile.General
+	general.Site = site
+	content := *profile
+	content.General = &general
+	profile = &content
+
+	bodyContent, err := xml.Marshal(profile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for mobile device configuration profile: %v", profile.General.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for mobile device configuration profile: %v (%s)", profile.General.Name, ep)
+	}
+
+	res := MobileDeviceConfigurationProfileContents{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for mobile device configuration profile: %v (%s)", profile.General.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteMobileDeviceConfigurationProfile will delete a mobile device configuration profile by either ID or Name
+func (j *Client) DeleteMobileDeviceConfigurationProfile(identifier any) (*MobileDeviceConfigurationProfileContents, error) {
+	return j.DeleteMobileDeviceConfigurationProfileContext(context.Background(), identifier)
+}
+
+// DeleteMobileDeviceConfigurationProfileContext is like DeleteMobileDeviceConfigurationProfile but uses the given context for the request
+func (j *Client) DeleteMobileDeviceConfigurationProfileContext(ctx context.Context, identifier any) (*MobileDeviceConfigurationProfileContents, error) {
+	ep, err := EndpointBuilder(j.Endpoint, mobileDeviceConfigurationProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for mobile device configuration profile: %v", identifier)
+	}
+
+	if err := checkExistingSite(ctx, j, identifier, j.MobileDeviceConfigurationProfileDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for mobile device configuration profile: %v (%s)", identifier, ep)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for mobile device configuration profile: %v (%s)", identifier, ep)
+	}
+
+	res := MobileDeviceConfigurationProfileContents{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for mobile device configuration profile: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,72 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "encoding/xml"
+
+// MobileDeviceConfigurationProfiles represents a list of mobile device configuration profiles in Jamf
+type MobileDeviceConfigurationProfiles struct {
+	List []BasicMobileDeviceConfigurationProfileInfo `json:"configuration_profiles" xml:"configuration_profile,omitempty"`
+	Size int                                         `json:"size" xml:"size"`
+}
+
+// BasicMobileDeviceConfigurationProfileInfo represents the information returned in a list of all
+// mobile device configuration profiles from Jamf
+type BasicMobileDeviceConfigurationProfileInfo struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name"`
+}
+
+// MobileDeviceConfigurationProfile represents a single mobile device configuration profile in Jamf
+type MobileDeviceConfigurationProfile struct {
+	Content MobileDeviceConfigurationProfileContents `json:"configuration_profile" xml:"configuration_profile,omitempty"`
+}
+
+// UnmarshalXML decodes the configuration_profile element returned when the classic API responds
+// with XML since unlike the JSON response it isn't wrapped in a parent object
+func (p *MobileDeviceConfigurationProfile) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&p.Content, &start)
+}
+
+// MobileDeviceConfigurationProfileContents holds the details associated with a given mobile device
+// configuration profile. ID is only populated from create, update and delete responses which Jamf
+// returns as a bare ID
+type MobileDeviceConfigurationProfileContents struct {
+	XMLName     xml.Name                                 `json:"-" xml:"configuration_profile,omitempty"`
+	ID          int                                      `json:"-" xml:"id,omitempty"`
+	General     *MobileDeviceConfigurationProfileGeneral `json:"general" xml:"general,omitempty"`
+	Scope       *MobileDeviceConfigurationProfileScope   `json:"scope" xml:"scope,omitempty"`
+	SelfService *SelfService                             `json:"self_service" xml:"self_service,omitempty"`
+}
+
+// MobileDeviceConfigurationProfileGeneral holds the general settings of a mobile device configuration profile.
+// Payloads holds the raw .mobileconfig property list, use MobileConfig to access its parsed contents
+type MobileDeviceConfigurationProfileGeneral struct {
+	ID                                   int             `json:"id,omitempty" xml:"id,omitempty"`
+	Name                                 string          `json:"name" xml:"name,omitempty"`
+	Description                          string          `json:"description" xml:"description,omitempty"`
+	Site                                 *Site           `json:"site,omitempty" xml:"site,omitempty"`
+	Category                             *PolicyCategory `json:"category,omitempty" xml:"category,omitempty"`
+	Level                                string          `json:"level" xml:"level,omitempty"`
+	UUID                                 string          `json:"uuid" xml:"uuid,omitempty"`
+	DeploymentMethod                     string          `json:"deployment_method" xml:"deployment_method,omitempty"`
+	RedeployOnUpdate                     string          `json:"redeploy_on_update" xml:"redeploy_on_update,omitempty"`
+	RedeployDaysBeforeCertificateExpires int             `json:"redeploy_Days_before_certificate_expires" xml:"redeploy_Days_before_certificate_expires,omitempty"`
+	Payloads                             string          `json:"payloads" xml:"payloads,omitempty"`
+}
+
+// MobileConfig parses the .mobileconfig property list held in Payloads
+func (g *MobileDeviceConfigurationProfileGeneral) MobileConfig() (*MobileConfig, error) {
+	return ParseMobileConfig(g.Payloads)
+}
+
+// MobileDeviceConfigurationProfileScope extends Scope with the mobile device targets a
+// mobile device configuration profile can be scoped to
+type MobileDeviceConfigurationProfileScope struct {
+	Scope
+	AllMobileDevices   bool                         `json:"all_mobile_devices" xml:"all_mobile_devices"`
+	AllJSSUsers        bool                         `json:"all_jss_users" xml:"all_jss_users"`
+	MobileDevices      []GeneralDeviceInformation   `json:"mobile_devices" xml:"mobile_devices>mobile_device,omitempty"`
+	MobileDeviceGroups []BasicMobileDeviceGroupInfo `json:"mobile_device_groups" xml:"mobile_device_groups>mobile_device_group,omitempty"`
+}
@@ -0,0 +1,231 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var MOBILE_DEVICE_CONFIG_PROFILES_BASE_API_ENDPOINT = "/JSSResource/mobiledeviceconfigurationprofiles"
+
+func mobileDeviceConfigurationProfilesResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case MOBILE_DEVICE_CONFIG_PROFILES_BASE_API_ENDPOINT:
+			switch r.Header.Get("X-Test-Format") {
+			case "xml":
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+					<configuration_profiles>
+						<size>2</size>
+						<configuration_profile><id>4</id><name>Corporate Wi-Fi</name></configuration_profile>
+						<configuration_profile><id>5</id><name>Restrictions</name></configuration_profile>
+					</configuration_profiles>`)
+			default:
+				fmt.Fprint(w, `{
+					"configuration_profiles": [
+						{
+							"id": 4,
+							"name": "Corporate Wi-Fi"
+						},
+						{
+							"id": 5,
+							"name": "Restrictions"
+						}]
+					}`)
+			}
+		case fmt.Sprintf("%s/id/4", MOBILE_DEVICE_CONFIG_PROFILES_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{
+					"configuration_profile": {
+						"general": {
+							"id": 4,
+							"name": "Corporate Wi-Fi",
+							"description": "",
+							"level": "Device Level",
+							"site": {"id": -1, "name": "None"},
+							"category": {"id": -1, "name": "No category assigned"},
+							"uuid": "0E7F3C1A-2B4D-4C6E-8F0A-1B3C5D7E9F21",
+							"deployment_method": "Install Automatically",
+							"redeploy_on_update": "Newly Assigned",
+							"redeploy_Days_before_certificate_expires": 14,
+							"payloads": "<?xml version=\"1.0\" encoding=\"UTF-8\"?><plist version=\"1\"><dict><key>PayloadDisplayName</key><string>Corporate Wi-Fi</string><key>PayloadContent</key><array><dict><key>PayloadType</key><string>com.apple.wifi.managed</string><key>SSID_STR</key><string>corp</string><key>AutoJoin</key><true/></dict></array></dict></plist>"
+						},
+						"scope": {
+							"all_mobile_devices": false,
+							"all_jss_users": false,
+							"mobile_devices": [{"id": 7, "name": "Test iPad #7", "udid": "270aae10800b6e61a2ee2bbc285eb967050b5984"}],
+							"mobile_device_groups": [{"id": 1, "name": "Static iPads"}],
+							"buildings": [{"id": 1, "name": "Boston"}]
+						},
+						"self_service": {
+							"self_service_description": "Joins the corporate network",
+							"feature_on_main_page": true
+						}
+					}
+				}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				profile := &jamf.MobileDeviceConfigurationProfileContents{}
+				assert.Nil(t, xml.Unmarshal(data, profile))
+				assert.Equal(t, 2, profile.Scope.MobileDeviceGroups[0].ID)
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><configuration_profile><id>4</id></configuration_profile>`)
+			case "DELETE":
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><configuration_profile><id>4</id></configuration_profile>`)
+			}
+		case fmt.Sprintf("%s/name/Restrictions", MOBILE_DEVICE_CONFIG_PROFILES_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<configuration_profile>
+					<general>
+						<id>5</id>
+						<name>Restrictions</name>
+						<level>Device Level</level>
+						<deployment_method>Install Automatically</deployment_method>
+						<redeploy_on_update>Newly Assigned</redeploy_on_update>
+						<redeploy_Days_before_certificate_expires>0</redeploy_Days_before_certificate_expires>
+						<payloads>&lt;?xml version="1.0" encoding="UTF-8"?&gt;&lt;plist version="1"&gt;&lt;dict&gt;&lt;key&gt;PayloadContent&lt;/key&gt;&lt;array&gt;&lt;dict&gt;&lt;key&gt;PayloadType&lt;/key&gt;&lt;string&gt;com.apple.applicationaccess&lt;/string&gt;&lt;key&gt;allowCamera&lt;/key&gt;&lt;false/&gt;&lt;/dict&gt;&lt;/array&gt;&lt;/dict&gt;&lt;/plist&gt;</payloads>
+					</general>
+					<scope>
+						<all_mobile_devices>true</all_mobile_devices>
+						<all_jss_users>false</all_jss_users>
+						<mobile_devices/>
+						<mobile_device_groups>
+							<mobile_device_group><id>2</id><name>Stale iPads</name></mobile_device_group>
+						</mobile_device_groups>
+					</scope>
+				</configuration_profile>`)
+		case fmt.Sprintf("%s/id/-1", MOBILE_DEVICE_CONFIG_PROFILES_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			profile := &jamf.MobileDeviceConfigurationProfileContents{}
+			assert.Nil(t, xml.Unmarshal(data, profile))
+			assert.Equal(t, "Restrictions", profile.General.Name)
+			assert.True(t, profile.Scope.AllMobileDevices)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><configuration_profile><id>6</id></configuration_profile>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf mobile device configuration profiles API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestMobileDeviceConfigurationProfiles(t *testing.T) {
+	testServer := mobileDeviceConfigurationProfilesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	profiles, err := j.MobileDeviceConfigurationProfiles()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(profiles))
+	assert.Equal(t, 4, profiles[0].ID)
+	assert.Equal(t, "Restrictions", profiles[1].Name)
+}
+
+func TestMobileDeviceConfigurationProfiles__XML(t *testing.T) {
+	testServer := mobileDeviceConfigurationProfilesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithMiddleware(func(next jamf.Doer) jamf.Doer {
+		return jamf.DoerFunc(func(r *http.Request) (*http.Response, error) {
+			r.Header.Set("X-Test-Format", "xml")
+			return next.Do(r)
+		})
+	}))
+	assert.Nil(t, err)
+
+	profiles, err := j.MobileDeviceConfigurationProfiles()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(profiles))
+	assert.Equal(t, 5, profiles[1].ID)
+	assert.Equal(t, "Corporate Wi-Fi", profiles[0].Name)
+}
+
+func TestMobileDeviceConfigurationProfileDetails__JSON(t *testing.T) {
+	testServer := mobileDeviceConfigurationProfilesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	profile, err := j.MobileDeviceConfigurationProfileDetails(4)
+	assert.Nil(t, err)
+	general := profile.Content.General
+	assert.Equal(t, "Corporate Wi-Fi", general.Name)
+	assert.Equal(t, "Install Automatically", general.DeploymentMethod)
+	assert.Equal(t, 14, general.RedeployDaysBeforeCertificateExpires)
+
+	scope := profile.Content.Scope
+	assert.False(t, scope.AllMobileDevices)
+	assert.Equal(t, "270aae10800b6e61a2ee2bbc285eb967050b5984", scope.MobileDevices[0].UDID)
+	assert.Equal(t, "Static iPads", scope.MobileDeviceGroups[0].Name)
+	assert.Equal(t, "Boston", scope.Buildings[0].Name)
+	assert.True(t, profile.Content.SelfService.MainPageFeature)
+
+	config, err := general.MobileConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "Corporate Wi-Fi", config.DisplayName)
+	assert.Equal(t, "com.apple.wifi.managed", config.Payloads[0].Type)
+	assert.Equal(t, "corp", config.Payloads[0].Settings["SSID_STR"])
+	assert.Equal(t, true, config.Payloads[0].Settings["AutoJoin"])
+}
+
+func TestMobileDeviceConfigurationProfileDetails__XML(t *testing.T) {
+	testServer := mobileDeviceConfigurationProfilesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	profile, err := j.MobileDeviceConfigurationProfileDetails("Restrictions")
+	assert.Nil(t, err)
+	assert.Equal(t, 5, profile.Content.General.ID)
+	assert.Equal(t, "Device Level", profile.Content.General.Level)
+	assert.True(t, profile.Content.Scope.AllMobileDevices)
+	assert.Empty(t, profile.Content.Scope.MobileDevices)
+	assert.Equal(t, 2, profile.Content.Scope.MobileDeviceGroups[0].ID)
+
+	config, err := profile.Content.General.MobileConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "com.apple.applicationaccess", config.Payloads[0].Type)
+	assert.Equal(t, false, config.Payloads[0].Settings["allowCamera"])
+}
+
+func TestCreateUpdateDeleteMobileDeviceConfigurationProfile(t *testing.T) {
+	testServer := mobileDeviceConfigurationProfilesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	_, err = j.CreateMobileDeviceConfigurationProfile(&jamf.MobileDeviceConfigurationProfileContents{})
+	assert.NotNil(t, err)
+
+	created, err := j.CreateMobileDeviceConfigurationProfile(&jamf.MobileDeviceConfigurationProfileContents{
+		General: &jamf.MobileDeviceConfigurationProfileGeneral{Name: "Restrictions"},
+		Scope:   &jamf.MobileDeviceConfigurationProfileScope{AllMobileDevices: true},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 6, created.ID)
+
+	updated, err := j.UpdateMobileDeviceConfigurationProfile(4, &jamf.MobileDeviceConfigurationProfileContents{
+		Scope: &jamf.MobileDeviceConfigurationProfileScope{
+			MobileDeviceGroups: []jamf.BasicMobileDeviceGroupInfo{{ID: 2}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, updated.ID)
+
+	deleted, err := j.DeleteMobileDeviceConfigurationProfile(4)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, deleted.ID)
+}
@@ -6,21 +6,184 @@ import "encoding/xml"
 
 // MobileDevices represents a list of all mobile devices enrolled in Jamf
 type MobileDevices struct {
-	List  []MobileDevice `json:"mobile_devices" xml:"mobile_devices>mobile_device,omitempty"`
-	Count int            `json:"-" xml:"size"`
+	List  []BasicMobileDeviceInfo `json:"mobile_devices" xml:"mobile_device,omitempty"`
+	Count int                     `json:"-" xml:"size"`
 }
 
-// BasicComputerInfo represents the information returned in a list of all computers from Jamf
+// BasicMobileDeviceInfo represents the information returned in a list of all mobile devices from Jamf
 type BasicMobileDeviceInfo struct {
 	GeneralDeviceInformation
+	Managed  bool   `json:"managed,omitempty" xml:"managed,omitempty"`
 	Username string `json:"username,omitempty" xml:"username,omitempty"`
 }
 
 // MobileDevice represents an individual mobile device enrolled in Jamf with all its associated information
 type MobileDevice struct {
-	Info struct {
-		General GeneralDeviceInformation `json:"general"`
-	}
+	Info MobileDeviceDetails `json:"mobile_device" xml:"mobile_device,omitempty"`
+}
+
+// UnmarshalXML decodes the mobile_device element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (d *MobileDevice) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&d.Info, &start)
+}
+
+// MobileDeviceDetails holds the sections of a mobile device's inventory. Sections which are not
+// requested (i.e when using GetMobileDeviceSubset) are left empty
+type MobileDeviceDetails struct {
+	XMLName              xml.Name                          `json:"-" xml:"mobile_device,omitempty"`
+	ID                   int                               `json:"id,omitempty" xml:"id,omitempty"`
+	General              MobileDeviceGeneral               `json:"general" xml:"general,omitempty"`
+	Location             MobileDeviceLocation              `json:"location" xml:"location,omitempty"`
+	Purchasing           MobileDevicePurchasing            `json:"purchasing" xml:"purchasing,omitempty"`
+	Applications         []MobileDeviceApplication         `json:"applications" xml:"applications>application,omitempty"`
+	Security             MobileDeviceSecurity              `json:"security" xml:"security,omitempty"`
+	Network              MobileDeviceNetwork               `json:"network" xml:"network,omitempty"`
+	Certificates         []MobileDeviceCertificate         `json:"certificates" xml:"certificates>certificate,omitempty"`
+	ConfigProfiles       []MobileDeviceConfigProfile       `json:"configuration_profiles" xml:"configuration_profiles>configuration_profile,omitempty"`
+	ProvisioningProfiles []MobileDeviceProvisioningProfile `json:"provisioning_profiles" xml:"provisioning_profiles>mobile_device_provisioning_profile,omitempty"`
+	Groups               []MobileDeviceGroupMembership     `json:"mobile_device_groups" xml:"mobile_device_groups>mobile_device_group,omitempty"`
+	ExtensionAttributes  []ExtensionAttribute              `json:"extension_attributes" xml:"extension_attributes>extension_attribute,omitempty"`
+}
+
+// MobileDeviceGeneral holds the general and hardware information associated with a mobile device
+type MobileDeviceGeneral struct {
+	ID                          int    `json:"id,omitempty" xml:"id,omitempty"`
+	DisplayName                 string `json:"display_name,omitempty" xml:"display_name,omitempty"`
+	DeviceName                  string `json:"device_name,omitempty" xml:"device_name,omitempty"`
+	Name                        string `json:"name,omitempty" xml:"name,omitempty"`
+	AssetTag                    string `json:"asset_tag,omitempty" xml:"asset_tag,omitempty"`
+	LastInventoryUpdate         string `json:"last_inventory_update,omitempty" xml:"last_inventory_update,omitempty"`
+	LastInventoryUpdateUTC      string `json:"last_inventory_update_utc,omitempty" xml:"last_inventory_update_utc,omitempty"`
+	Capacity                    int    `json:"capacity,omitempty" xml:"capacity,omitempty"`
+	CapacityMB                  int    `json:"capacity_mb,omitempty" xml:"capacity_mb,omitempty"`
+	Available                   int    `json:"available,omitempty" xml:"available,omitempty"`
+	AvailableMB                 int    `json:"available_mb,omitempty" xml:"available_mb,omitempty"`
+	PercentageUsed              int    `json:"percentage_used,omitempty" xml:"percentage_used,omitempty"`
+	OSType                      string `json:"os_type,omitempty" xml:"os_type,omitempty"`
+	OSVersion                   string `json:"os_version,omitempty" xml:"os_version,omitempty"`
+	OSBuild                     string `json:"os_build,omitempty" xml:"os_build,omitempty"`
+	SerialNumber                string `json:"serial_number,omitempty" xml:"serial_number,omitempty"`
+	UDID                        string `json:"udid,omitempty" xml:"udid,omitempty"`
+	InitialEntryDateUTC         string `json:"initial_entry_date_utc,omitempty" xml:"initial_entry_date_utc,omitempty"`
+	PhoneNumber                 string `json:"phone_number,omitempty" xml:"phone_number,omitempty"`
+	IPAddress                   string `json:"ip_address,omitempty" xml:"ip_address,omitempty"`
+	WifiMACAddress              string `json:"wifi_mac_address,omitempty" xml:"wifi_mac_address,omitempty"`
+	BluetoothMACAddress         string `json:"bluetooth_mac_address,omitempty" xml:"bluetooth_mac_address,omitempty"`
+	ModemFirmware               string `json:"modem_firmware,omitempty" xml:"modem_firmware,omitempty"`
+	Model                       string `json:"model,omitempty" xml:"model,omitempty"`
+	ModelIdentifier             string `json:"model_identifier,omitempty" xml:"model_identifier,omitempty"`
+	ModelNumber                 string `json:"model_number,omitempty" xml:"model_number,omitempty"`
+	ModelDisplay                string `json:"model_display,omitempty" xml:"model_display,omitempty"`
+	DeviceOwnershipLevel        string `json:"device_ownership_level,omitempty" xml:"device_ownership_level,omitempty"`
+	EnrollmentMethod            string `json:"enrollment_method,omitempty" xml:"enrollment_method,omitempty"`
+	LastEnrollmentUTC           string `json:"last_enrollment_utc,omitempty" xml:"last_enrollment_utc,omitempty"`
+	MDMProfileExpirationUTC     string `json:"mdm_profile_expiration_utc,omitempty" xml:"mdm_profile_expiration_utc,omitempty"`
+	Managed                     bool   `json:"managed,omitempty" xml:"managed,omitempty"`
+	Supervised                  bool   `json:"supervised,omitempty" xml:"supervised,omitempty"`
+	Shared                      string `json:"shared,omitempty" xml:"shared,omitempty"`
+	Tethered                    string `json:"tethered,omitempty" xml:"tethered,omitempty"`
+	BatteryLevel                int    `json:"battery_level,omitempty" xml:"battery_level,omitempty"`
+	DeviceLocatorServiceEnabled bool   `json:"device_locator_service_enabled,omitempty" xml:"device_locator_service_enabled,omitempty"`
+	DoNotDisturbEnabled         bool   `json:"do_not_disturb_enabled,omitempty" xml:"do_not_disturb_enabled,omitempty"`
+	CloudBackupEnabled          bool   `json:"cloud_backup_enabled,omitempty" xml:"cloud_backup_enabled,omitempty"`
+	LastCloudBackupDateUTC      string `json:"last_cloud_backup_date_utc,omitempty" xml:"last_cloud_backup_date_utc,omitempty"`
+	LocationServicesEnabled     bool   `json:"location_services_enabled,omitempty" xml:"location_services_enabled,omitempty"`
+	ITunesStoreAccountIsActive  bool   `json:"itunes_store_account_is_active,omitempty" xml:"itunes_store_account_is_active,omitempty"`
+	Site                        *Site  `json:"site,omitempty" xml:"site,omitempty"`
+}
+
+// MobileDeviceLocation holds the information in the User & Location section
+type MobileDeviceLocation struct {
+	Username     string `json:"username,omitempty" xml:"username,omitempty"`
+	RealName     string `json:"realname,omitempty" xml:"realname,omitempty"`
+	EmailAddress string `json:"email_address,omitempty" xml:"email_address,omitempty"`
+	Position     string `json:"position,omitempty" xml:"position,omitempty"`
+	Phone        string `json:"phone,omitempty" xml:"phone,omitempty"`
+	Department   string `json:"department,omitempty" xml:"department,omitempty"`
+	Building     string `json:"building,omitempty" xml:"building,omitempty"`
+	Room         string `json:"room,omitempty" xml:"room,omitempty"`
+}
+
+// MobileDevicePurchasing holds the purchasing information of a mobile device
+type MobileDevicePurchasing struct {
+	IsPurchased       bool   `json:"is_purchased,omitempty" xml:"is_purchased,omitempty"`
+	IsLeased          bool   `json:"is_leased,omitempty" xml:"is_leased,omitempty"`
+	PONumber          string `json:"po_number,omitempty" xml:"po_number,omitempty"`
+	Vendor            string `json:"vendor,omitempty" xml:"vendor,omitempty"`
+	AppleCareID       string `json:"applecare_id,omitempty" xml:"applecare_id,omitempty"`
+	PurchasePrice     string `json:"purchase_price,omitempty" xml:"purchase_price,omitempty"`
+	PurchasingAccount string `json:"purchasing_account,omitempty" xml:"purchasing_account,omitempty"`
+	PODate            string `json:"po_date,omitempty" xml:"po_date,omitempty"`
+	WarrantyExpires   string `json:"warranty_expires,omitempty" xml:"warranty_expires,omitempty"`
+	LeaseExpires      string `json:"lease_expires,omitempty" xml:"lease_expires,omitempty"`
+	LifeExpectancy    int    `json:"life_expectancy,omitempty" xml:"life_expectancy,omitempty"`
+	PurchasingContact string `json:"purchasing_contact,omitempty" xml:"purchasing_contact,omitempty"`
+}
+
+// MobileDeviceApplication holds information about an application installed on a mobile device
+type MobileDeviceApplication struct {
+	Name         string `json:"application_name" xml:"application_name"`
+	Version      string `json:"application_version" xml:"application_version"`
+	ShortVersion string `json:"application_short_version,omitempty" xml:"application_short_version"`
+	Identifier   string `json:"identifier" xml:"identifier"`
+}
+
+// MobileDeviceSecurity holds the security information of a mobile device
+type MobileDeviceSecurity struct {
+	DataProtection                  bool   `json:"data_protection" xml:"data_protection"`
+	BlockLevelEncryptionCapable     bool   `json:"block_level_encryption_capable" xml:"block_level_encryption_capable"`
+	FileLevelEncryptionCapable      bool   `json:"file_level_encryption_capable" xml:"file_level_encryption_capable"`
+	PasscodePresent                 bool   `json:"passcode_present" xml:"passcode_present"`
+	PasscodeCompliant               bool   `json:"passcode_compliant" xml:"passcode_compliant"`
+	PasscodeCompliantWithProfile    bool   `json:"passcode_compliant_with_profile" xml:"passcode_compliant_with_profile"`
+	PasscodeLockGracePeriodEnforced string `json:"passcode_lock_grace_period_enforced,omitempty" xml:"passcode_lock_grace_period_enforced"`
+	HardwareEncryption              int    `json:"hardware_encryption,omitempty" xml:"hardware_encryption"`
+	ActivationLockEnabled           bool   `json:"activation_lock_enabled" xml:"activation_lock_enabled"`
+	JailbreakDetected               string `json:"jailbreak_detected,omitempty" xml:"jailbreak_detected"`
+	LostModeEnabled                 string `json:"lost_mode_enabled,omitempty" xml:"lost_mode_enabled"`
+	LostModeEnforced                bool   `json:"lost_mode_enforced" xml:"lost_mode_enforced"`
+}
+
+// MobileDeviceNetwork holds the cellular network information of a mobile device
+type MobileDeviceNetwork struct {
+	HomeCarrierNetwork    string `json:"home_carrier_network,omitempty" xml:"home_carrier_network"`
+	CellularTechnology    string `json:"cellular_technology,omitempty" xml:"cellular_technology"`
+	VoiceRoamingEnabled   string `json:"voice_roaming_enabled,omitempty" xml:"voice_roaming_enabled"`
+	IMEI                  string `json:"imei,omitempty" xml:"imei"`
+	ICCID                 string `json:"iccid,omitempty" xml:"iccid"`
+	CurrentCarrierNetwork string `json:"current_carrier_network,omitempty" xml:"current_carrier_network"`
+	DataRoamingEnabled    bool   `json:"data_roaming_enabled" xml:"data_roaming_enabled"`
+	Roaming               bool   `json:"roaming" xml:"roaming"`
+	PhoneNumber           string `json:"phone_number,omitempty" xml:"phone_number"`
+}
+
+// MobileDeviceCertificate holds information about a certificate installed on a mobile device
+type MobileDeviceCertificate struct {
+	CommonName string `json:"common_name" xml:"common_name"`
+	Identity   bool   `json:"identity" xml:"identity"`
+	ExpiresUTC string `json:"expires_utc" xml:"expires_utc"`
+}
+
+// MobileDeviceConfigProfile represents a configuration profile installed on a mobile device
+type MobileDeviceConfigProfile struct {
+	DisplayName string `json:"display_name" xml:"display_name"`
+	Version     string `json:"version,omitempty" xml:"version"`
+	Identifier  string `json:"identifier" xml:"identifier"`
+	UUID        string `json:"uuid" xml:"uuid"`
+}
+
+// MobileDeviceProvisioningProfile represents a provisioning profile installed on a mobile device
+type MobileDeviceProvisioningProfile struct {
+	DisplayName    string `json:"display_name" xml:"display_name"`
+	UUID           string `json:"uuid" xml:"uuid"`
+	ExpirationDate string `json:"expiration_date,omitempty" xml:"expiration_date"`
+}
+
+// MobileDeviceGroupMembership represents a mobile device group the device is a member of
+type MobileDeviceGroupMembership struct {
+	ID   int    `json:"id" xml:"id"`
+	Name string `json:"name" xml:"name"`
 }
 
 // GeneralDeviceInformation holds basic information associated with Jamf mobile device
@@ -0,0 +1,191 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// MobileDeviceGroups returns a list of mobile device groups in Jamf
+func (j *Client) MobileDeviceGroups() ([]BasicMobileDeviceGroupInfo, error) {
+	return j.MobileDeviceGroupsContext(context.Background())
+}
+
+// MobileDeviceGroupsContext is like MobileDeviceGroups but uses the given context for the request
+func (j *Client) MobileDeviceGroupsContext(ctx context.Context) ([]BasicMobileDeviceGroupInfo, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, mobileDeviceGroupsContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf mobile device groups query request")
+	}
+	res := MobileDeviceGroups{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available mobile device groups from %s", ep)
+	}
+	return filterBySite(ctx, j, res.List, func(ctx context.Context, item BasicMobileDeviceGroupInfo) error {
+		_, err := j.MobileDeviceGroupDetailsContext(ctx, item.ID)
+		return err
+	})
+}
+
+// MobileDeviceGroupDetails returns the details for a specific group given its ID or Name
+func (j *Client) MobileDeviceGroupDetails(identifier any) (*MobileDeviceGroup, error) {
+	return j.MobileDeviceGroupDetailsContext(context.Background(), identifier)
+}
+
+// MobileDeviceGroupDetailsContext is like MobileDeviceGroupDetails but uses the given context for the request
+func (j *Client) MobileDeviceGroupDetailsContext(ctx context.Context, identifier any) (*MobileDeviceGroup, error) {
+	ep, err := EndpointBuilder(j.Endpoint, mobileDeviceGroupsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for mobile device group: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for mobile device group: %v", identifier)
+	}
+
+	res := MobileDeviceGroup{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile device group: %v from %s", identifier, ep)
+	}
+	if err := j.checkSite(res.Info.Site); err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile device group: %v from %s", identifier, ep)
+	}
+	return &res, nil
+}
+
+// UpdateMobileDeviceGroup will update the name, site and criteria of a mobile device group in Jamf by either group ID or group Name
+func (j *Client) UpdateMobileDeviceGroup(identifier any, updates *MobileDeviceGroupDetails) (*MobileDeviceGroupDetails, error) {
+	return j.UpdateMobileDeviceGroupContext(context.Background(), identifier, updates)
+}
+
+// UpdateMobileDeviceGroupContext is like UpdateMobileDeviceGroup but uses the given context for the request
+func (j *Client) UpdateMobileDeviceGroupContext(ctx context.Context, identifier any, updates *MobileDeviceGroupDetails) (*MobileDeviceGroupDetails, error) {
+	// groups can't be moved out of the site the client is pinned to
+	if _, err := j.clientSite(updates.Site); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for mobile device group: %v", identifier)
+	}
+	return j.putMobileDeviceGroup(ctx, identifier, updates)
+}
+
+// UpdateMobileDeviceGroupMembers will update the members of a static mobile device group in Jamf by either group ID or group Name
+func (j *Client) UpdateMobileDeviceGroupMembers(identifier any, updates *MobileDeviceGroupBindingChanges) (*MobileDeviceGroupDetails, error) {
+	return j.UpdateMobileDeviceGroupMembersContext(context.Background(), identifier, updates)
+}
+
+// UpdateMobileDeviceGroupMembersContext is like UpdateMobileDeviceGroupMembers but uses the given context for the request
+func (j *Client) UpdateMobileDeviceGroupMembersContext(ctx context.Context, identifier any, updates *MobileDeviceGroupBindingChanges) (*MobileDeviceGroupDetails, error) {
+	return j.putMobileDeviceGroup(ctx, identifier, updates)
+}
+
+func (j *Client) putMobileDeviceGroup(ctx context.Context, identifier any, updates any) (*MobileDeviceGroupDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, mobileDeviceGroupsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for mobile device group: %v", identifier)
+	}
+
+	if err := checkExistingSite(ctx, j, identifier, j.MobileDeviceGroupDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for mobile device group: %v (%s)", identifier, ep)
+	}
+
+	bodyContent, err := xml.Marshal(updates)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for mobile device group: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for mobile device group: %v (%s)", identifier, ep)
+	}
+
+	res := MobileDeviceGroupDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for mobile device group: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateMobileDeviceGroup will create a static or smart mobile device group in Jamf
+func (j *Client) CreateMobileDeviceGroup(newGroup *MobileDeviceGroupDetails) (*MobileDeviceGroupDetails, error) {
+	return j.CreateMobileDeviceGroupContext(context.Background(), newGroup)
+}
+
+// CreateMobileDeviceGroupContext is like CreateMobileDeviceGroup but uses the given context for the request
+func (j *Client) CreateMobileDeviceGroupContext(ctx context.Context, newGroup *MobileDeviceGroupDetails) (*MobileDeviceGroupDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, mobileDeviceGroupsContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF add mobile device group request endpoint")
+	}
+
+	if newGroup.Name == "" {
+		return nil, errors.New("error building JAMF add mobile device group request: group name is required")
+	}
+
+	if !newGroup.IsSmart && len(newGroup.Criteria) > 0 {
+		return nil, errors.New("error building JAMF add mobile device group request: criteria can only be set on smart groups")
+	}
+
+	site, err := j.clientSite(newGroup.Site)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF add mobile device group request")
+	}
+	group := *newGroup
+	group.Site = site
+
+	bodyContent, err := xml.Marshal(&group)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF add mobile device group payload")
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF add mobile device group request")
+	}
+
+	res := MobileDeviceGroupDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrap(err, "unable to process JAMF add mobile device group request")
+	}
+
+	return &res, nil
+}
+
+// DeleteMobileDeviceGroup will delete a mobile device group by either ID or Name
+func (j *Client) DeleteMobileDeviceGroup(identifier any) (*MobileDeviceGroupDetails, error) {
+	return j.DeleteMobileDeviceGroupContext(context.Background(), identifier)
+}
+
+// DeleteMobileDeviceGroupContext is like DeleteMobileDeviceGroup but uses the given context for the request
+func (j *Client) DeleteMobileDeviceGroupContext(ctx context.Context, identifier any) (*MobileDeviceGroupDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, mobileDeviceGroupsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF delete mobile device group request endpoint for group: %v", identifier)
+	}
+
+	if err := checkExistingSite(ctx, j, identifier, j.MobileDeviceGroupDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF delete mobile device group request for group: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF delete mobile device group request for group: %v", identifier)
+	}
+
+	res := MobileDeviceGroupDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF delete mobile device group request for group: %v", identifier)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,49 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "encoding/xml"
+
+// MobileDeviceGroups represents a list of mobile device groups in Jamf
+type MobileDeviceGroups struct {
+	List []BasicMobileDeviceGroupInfo `json:"mobile_device_groups" xml:"mobile_device_group,omitempty"`
+	Size int                          `json:"size" xml:"size"`
+}
+
+// MobileDeviceGroup represents a group a mobile device is a member of in Jamf
+type MobileDeviceGroup struct {
+	Info MobileDeviceGroupDetails `json:"mobile_device_group" xml:"mobile_device_group,omitempty"`
+}
+
+// UnmarshalXML decodes the mobile_device_group element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (g *MobileDeviceGroup) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&g.Info, &start)
+}
+
+// BasicMobileDeviceGroupInfo represents the information returned in a list of all
+// mobile device groups from Jamf
+type BasicMobileDeviceGroupInfo struct {
+	ID      int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name    string `json:"name,omitempty" xml:"name"`
+	IsSmart bool   `json:"is_smart" xml:"is_smart"`
+}
+
+// MobileDeviceGroupDetails represents the detailed information for a specific mobile device group.
+// Criteria only apply to smart groups while static groups list their members in MobileDevices
+type MobileDeviceGroupDetails struct {
+	XMLName xml.Name `json:"-" xml:"mobile_device_group,omitempty"`
+	BasicMobileDeviceGroupInfo
+	Site          *Site                   `json:"site,omitempty" xml:"site,omitempty"`
+	Criteria      []Criterion             `json:"criteria" xml:"criteria>criterion,omitempty"`
+	MobileDevices []BasicMobileDeviceInfo `json:"mobile_devices" xml:"mobile_devices>mobile_device,omitempty"`
+}
+
+// MobileDeviceGroupBindingChanges represents the changes to a mobile device group binding when
+// updating the members of a static mobile device group in Jamf
+type MobileDeviceGroupBindingChanges struct {
+	XMLName   xml.Name                   `json:"-" xml:"mobile_device_group,omitempty"`
+	Additions []GeneralDeviceInformation `xml:"mobile_device_additions>mobile_device"`
+	Removals  []GeneralDeviceInformation `xml:"mobile_device_deletions>mobile_device"`
+}
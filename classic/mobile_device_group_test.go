@@ -0,0 +1,231 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var MOBILE_DEVICE_GROUPS_BASE_API_ENDPOINT = "/JSSResource/mobiledevicegroups"
+
+func mobileDeviceGroupsResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case MOBILE_DEVICE_GROUPS_BASE_API_ENDPOINT:
+			fmt.Fprint(w, `{
+				"mobile_device_groups": [
+					{
+						"id": 1,
+						"name": "Static iPads",
+						"is_smart": false
+					},
+					{
+						"id": 2,
+						"name": "Stale iPads",
+						"is_smart": true
+					}]
+				}`)
+		case fmt.Sprintf("%s/id/1", MOBILE_DEVICE_GROUPS_BASE_API_ENDPOINT), fmt.Sprintf("%s/name/Static%%20iPads", MOBILE_DEVICE_GROUPS_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			switch r.Method {
+			case "GET", "DELETE":
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+					<mobile_device_group>
+						<id>1</id>
+						<name>Static iPads</name>
+						<is_smart>false</is_smart>
+						<site><id>-1</id><name>None</name></site>
+						<criteria><size>0</size></criteria>
+						<mobile_devices>
+							<size>1</size>
+							<mobile_device>
+								<id>7</id>
+								<name>Test iPad #7</name>
+								<udid>270aae10800b6e61a2ee2bbc285eb967050b5984</udid>
+								<serial_number>C02Q7KHTGFWF</serial_number>
+							</mobile_device>
+						</mobile_devices>
+					</mobile_device_group>`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				changes := &jamf.MobileDeviceGroupBindingChanges{}
+				assert.Nil(t, xml.Unmarshal(data, changes))
+				assert.Equal(t, 8, changes.Additions[0].ID)
+				assert.Equal(t, 7, changes.Removals[0].ID)
+				group := &jamf.MobileDeviceGroupDetails{
+					BasicMobileDeviceGroupInfo: jamf.BasicMobileDeviceGroupInfo{ID: 1, Name: "Static iPads"},
+					MobileDevices: []jamf.BasicMobileDeviceInfo{
+						{GeneralDeviceInformation: jamf.GeneralDeviceInformation{ID: 8, Name: "Test iPad #8"}},
+					},
+				}
+				groupData, err := xml.MarshalIndent(group, "", "  ")
+				assert.Nil(t, err)
+				fmt.Fprint(w, string(groupData))
+			}
+		case fmt.Sprintf("%s/id/2", MOBILE_DEVICE_GROUPS_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{
+					"mobile_device_group": {
+						"id": 2,
+						"name": "Stale iPads",
+						"is_smart": true,
+						"site": {"id": -1, "name": "None"},
+						"criteria": [
+							{
+								"name": "Last Inventory Update",
+								"priority": 0,
+								"and_or": "and",
+								"search_type": "more than x days ago",
+								"value": "30",
+								"opening_paren": false,
+								"closing_paren": false
+							},
+							{
+								"name": "Model",
+								"priority": 1,
+								"and_or": "and",
+								"search_type": "like",
+								"value": "iPad",
+								"opening_paren": false,
+								"closing_paren": false
+							}
+						],
+						"mobile_devices": [{"id": 9, "name": "Old iPad", "udid": "0009", "serial_number": "C02OLD"}]
+					}
+				}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, string(data))
+			}
+		case fmt.Sprintf("%s/id/-1", MOBILE_DEVICE_GROUPS_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			group := &jamf.MobileDeviceGroupDetails{}
+			assert.Nil(t, xml.Unmarshal(data, group))
+			group.ID = 3
+			groupData, err := xml.MarshalIndent(group, "", "  ")
+			assert.Nil(t, err)
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, string(groupData))
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestListAllMobileDeviceGroups(t *testing.T) {
+	server := mobileDeviceGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+	grps, err := j.MobileDeviceGroups()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(grps))
+	assert.Equal(t, 1, grps[0].ID)
+	assert.Equal(t, "Static iPads", grps[0].Name)
+	assert.Equal(t, false, grps[0].IsSmart)
+	assert.Equal(t, 2, grps[1].ID)
+	assert.Equal(t, true, grps[1].IsSmart)
+}
+
+func TestQuerySpecificMobileDeviceGroups(t *testing.T) {
+	server := mobileDeviceGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+
+	// static group as XML
+	grp, err := j.MobileDeviceGroupDetails("Static iPads")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, grp.Info.ID)
+	assert.Equal(t, false, grp.Info.IsSmart)
+	assert.Equal(t, "None", grp.Info.Site.Name)
+	assert.Equal(t, 0, len(grp.Info.Criteria))
+	assert.Equal(t, 7, grp.Info.MobileDevices[0].ID)
+	assert.Equal(t, "C02Q7KHTGFWF", grp.Info.MobileDevices[0].SerialNumber)
+
+	// smart group as JSON
+	grp, err = j.MobileDeviceGroupDetails(2)
+	assert.Nil(t, err)
+	assert.Equal(t, true, grp.Info.IsSmart)
+	assert.Equal(t, []jamf.Criterion{
+		{Name: "Last Inventory Update", Priority: 0, AndOr: jamf.CriterionAnd, SearchType: jamf.SearchTypeMoreThanXDaysAgo, Value: "30"},
+		{Name: "Model", Priority: 1, AndOr: jamf.CriterionAnd, SearchType: jamf.SearchTypeLike, Value: "iPad"},
+	}, grp.Info.Criteria)
+	assert.Equal(t, "Old iPad", grp.Info.MobileDevices[0].Name)
+}
+
+func TestCreateMobileDeviceGroup(t *testing.T) {
+	server := mobileDeviceGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+
+	grp := &jamf.MobileDeviceGroupDetails{
+		BasicMobileDeviceGroupInfo: jamf.BasicMobileDeviceGroupInfo{Name: "Supervised iPads", IsSmart: true},
+		Criteria: []jamf.Criterion{
+			{Name: "Supervised", Priority: 0, AndOr: jamf.CriterionAnd, SearchType: jamf.SearchTypeIs, Value: "Supervised"},
+		},
+	}
+	createdGrp, err := j.CreateMobileDeviceGroup(grp)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, createdGrp.ID)
+	assert.Equal(t, "Supervised iPads", createdGrp.Name)
+	assert.Equal(t, true, createdGrp.IsSmart)
+	assert.Equal(t, grp.Criteria, createdGrp.Criteria)
+
+	_, err = j.CreateMobileDeviceGroup(&jamf.MobileDeviceGroupDetails{})
+	assert.NotNil(t, err)
+
+	_, err = j.CreateMobileDeviceGroup(&jamf.MobileDeviceGroupDetails{
+		BasicMobileDeviceGroupInfo: jamf.BasicMobileDeviceGroupInfo{Name: "Static"},
+		Criteria:                   grp.Criteria,
+	})
+	assert.NotNil(t, err)
+}
+
+func TestUpdateMobileDeviceGroup(t *testing.T) {
+	server := mobileDeviceGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+
+	grp, err := j.MobileDeviceGroupDetails(2)
+	assert.Nil(t, err)
+	grp.Info.Criteria[0].Value = "60"
+	updated, err := j.UpdateMobileDeviceGroup(2, &grp.Info)
+	assert.Nil(t, err)
+	assert.Equal(t, "60", updated.Criteria[0].Value)
+	assert.Equal(t, "Model", updated.Criteria[1].Name)
+
+	updated, err = j.UpdateMobileDeviceGroupMembers(1, &jamf.MobileDeviceGroupBindingChanges{
+		Additions: []jamf.GeneralDeviceInformation{{ID: 8}},
+		Removals:  []jamf.GeneralDeviceInformation{{ID: 7}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(updated.MobileDevices))
+	assert.Equal(t, 8, updated.MobileDevices[0].ID)
+}
+
+func TestDeleteMobileDeviceGroup(t *testing.T) {
+	server := mobileDeviceGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+	deletedGrp, err := j.DeleteMobileDeviceGroup(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deletedGrp.ID)
+	assert.Equal(t, "Static iPads", deletedGrp.Name)
+}
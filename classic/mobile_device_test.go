@@ -0,0 +1,364 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var MOBILE_DEVICE_API_BASE_ENDPOINT = "/JSSResource/mobiledevices"
+
+func mobileDeviceResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case MOBILE_DEVICE_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"mobile_devices": [
+					{
+						"id": 1,
+						"name": "Test iPad #1",
+						"device_name": "Test iPad #1",
+						"udid": "270aae10800b6e61a2ee2bbc285eb967050b5984",
+						"serial_number": "C02Q7KHTGFWF",
+						"phone_number": "",
+						"wifi_mac_address": "E0:AC:CB:97:36:G4",
+						"managed": true,
+						"supervised": true,
+						"model": "iPad Pro (12.9-inch)",
+						"model_identifier": "iPad6,8",
+						"model_display": "iPad Pro (12.9-inch)",
+						"username": "test.user"
+					},
+					{
+						"id": 2,
+						"name": "Test iPhone #2",
+						"udid": "370aae10800b6e61a2ee2bbc285eb967050b5985",
+						"serial_number": "C02Q7KHTGFWG",
+						"managed": false
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/1", MOBILE_DEVICE_API_BASE_ENDPOINT),
+			fmt.Sprintf("%s/udid/270aae10800b6e61a2ee2bbc285eb967050b5984", MOBILE_DEVICE_API_BASE_ENDPOINT),
+			fmt.Sprintf("%s/macaddress/E0:AC:CB:97:36:G4", MOBILE_DEVICE_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{
+					"mobile_device": {
+						"general": {
+							"id": 1,
+							"display_name": "Test iPad #1",
+							"device_name": "Test iPad #1",
+							"name": "Test iPad #1",
+							"asset_tag": "A-1234",
+							"capacity_mb": 256000,
+							"available_mb": 128000,
+							"percentage_used": 50,
+							"os_type": "iOS",
+							"os_version": "17.4",
+							"os_build": "21E219",
+							"serial_number": "C02Q7KHTGFWF",
+							"udid": "270aae10800b6e61a2ee2bbc285eb967050b5984",
+							"ip_address": "192.0.2.10",
+							"wifi_mac_address": "E0:AC:CB:97:36:G4",
+							"model": "iPad Pro (12.9-inch)",
+							"model_identifier": "iPad6,8",
+							"managed": true,
+							"supervised": true,
+							"battery_level": 87,
+							"site": {"id": -1, "name": "None"}
+						},
+						"location": {
+							"username": "test.user",
+							"realname": "Test User",
+							"email_address": "test.user@email.com",
+							"department": "Engineering",
+							"building": "Boston"
+						},
+						"applications": [{
+							"application_name": "Datadog",
+							"application_version": "3.1",
+							"application_short_version": "3.1",
+							"identifier": "com.datadoghq.app"
+						}],
+						"security": {
+							"data_protection": true,
+							"passcode_present": true,
+							"passcode_compliant": true,
+							"hardware_encryption": 3,
+							"activation_lock_enabled": false,
+							"jailbreak_detected": "Normal",
+							"lost_mode_enabled": "Unsupported"
+						},
+						"network": {
+							"imei": "35 123456 789012 3",
+							"data_roaming_enabled": false
+						},
+						"certificates": [{
+							"common_name": "JSS Built-in Certificate Authority",
+							"identity": false,
+							"expires_utc": "9027-11-12T20:07:28.000+0000"
+						}],
+						"configuration_profiles": [{
+							"display_name": "Wi-Fi",
+							"version": "1",
+							"identifier": "com.datadoghq.wifi",
+							"uuid": "7FEC28E0-1A9A-4A2D-A3A4-2F6AA1A34BF7"
+						}],
+						"mobile_device_groups": [{"id": 3, "name": "All Managed iPads"}],
+						"extension_attributes": [{"id": 4, "name": "Owner Team", "type": "String", "value": "IT"}]
+					}
+				}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				// inventory reported by the device is never sent back
+				for _, readOnly := range []string{"<mobile_device><id>", "<general><id>", "serial_number", "udid", "os_version", "managed", "applications", "security", "mobile_device_groups"} {
+					assert.NotContains(t, string(data), readOnly)
+				}
+				contents := &jamf.MobileDeviceDetails{}
+				assert.Nil(t, xml.Unmarshal(data, contents))
+				w.Header().Set("Content-Type", "application/xml")
+				fmt.Fprintf(w, `<mobile_device><id>1</id><general><name>%s</name><asset_tag>%s</asset_tag></general><location><username>%s</username></location><extension_attributes>%d</extension_attributes></mobile_device>`,
+					contents.General.Name, contents.General.AssetTag, contents.Location.Username, len(contents.ExtensionAttributes))
+			case "DELETE":
+				w.Header().Set("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><mobile_device><id>1</id></mobile_device>`)
+			}
+		case fmt.Sprintf("%s/id/3", MOBILE_DEVICE_API_BASE_ENDPOINT):
+			w.Header().Set("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<mobile_device>
+					<general>
+						<id>3</id>
+						<name>Test iPhone #3</name>
+						<os_type>iOS</os_type>
+						<os_version>17.4</os_version>
+						<serial_number>C02Q7KHTGFWH</serial_number>
+						<udid>470aae10800b6e61a2ee2bbc285eb967050b5986</udid>
+						<ip_address>192.0.2.11</ip_address>
+						<wifi_mac_address>E0:AC:CB:97:36:G5</wifi_mac_address>
+						<model>iPhone 15</model>
+						<model_identifier>iPhone15,4</model_identifier>
+						<managed>true</managed>
+						<supervised>true</supervised>
+					</general>
+					<applications>
+						<size>1</size>
+						<application>
+							<application_name>Datadog</application_name>
+							<application_version>3.1</application_version>
+							<identifier>com.datadoghq.app</identifier>
+						</application>
+					</applications>
+					<security>
+						<passcode_compliant>true</passcode_compliant>
+						<hardware_encryption>3</hardware_encryption>
+					</security>
+					<network><imei>35 123456 789012 4</imei></network>
+					<certificates>
+						<size>1</size>
+						<certificate><common_name>JSS Built-in Certificate Authority</common_name><identity>false</identity></certificate>
+					</certificates>
+					<configuration_profiles>
+						<size>1</size>
+						<configuration_profile><display_name>Wi-Fi</display_name><identifier>com.datadoghq.wifi</identifier></configuration_profile>
+					</configuration_profiles>
+					<mobile_device_groups>
+						<size>1</size>
+						<mobile_device_group><id>3</id><name>All Managed iPhones</name></mobile_device_group>
+					</mobile_device_groups>
+				</mobile_device>`)
+		case fmt.Sprintf("%s/serialnumber/C02Q7KHTGFWF/subset/General&Location", MOBILE_DEVICE_API_BASE_ENDPOINT):
+			w.Header().Set("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<mobile_device>
+					<general><id>1</id><name>Test iPad #1</name><asset_tag>A-1234</asset_tag></general>
+					<location><username>test.user</username><department>Engineering</department></location>
+				</mobile_device>`)
+		case fmt.Sprintf("%s/name/Test%%20iPad%%20%%232/subset/ExtensionAttributes", MOBILE_DEVICE_API_BASE_ENDPOINT):
+			w.Header().Set("Content-Type", "application/xml")
+			fmt.Fprint(w, `<mobile_device><extension_attributes><extension_attribute><id>4</id><name>Owner Team</name><type>String</type><value>IT</value></extension_attribute></extension_attributes></mobile_device>`)
+		case fmt.Sprintf("%s/serialnumber/C02Q7KHTGFWG", MOBILE_DEVICE_API_BASE_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, `<mobile_device><extension_attributes><extension_attribute><id>4</id><name></name><type></type><value>Platform</value></extension_attribute></extension_attributes></mobile_device>`, string(data))
+			w.Header().Set("Content-Type", "application/xml")
+			fmt.Fprint(w, `<mobile_device><id>2</id></mobile_device>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf mobile device API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestQueryMobileDevices(t *testing.T) {
+	testServer := mobileDeviceResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	devices, err := j.MobileDevices()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(devices))
+	assert.Equal(t, 1, devices[0].ID)
+	assert.Equal(t, "Test iPad #1", devices[0].Name)
+	assert.Equal(t, "C02Q7KHTGFWF", devices[0].SerialNumber)
+	assert.True(t, devices[0].Managed)
+	assert.True(t, devices[0].Supervised)
+	assert.Equal(t, "test.user", devices[0].Username)
+	assert.False(t, devices[1].Managed)
+}
+
+func TestGetMobileDevice(t *testing.T) {
+	testServer := mobileDeviceResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	for _, identifier := range []*jamf.MobileDeviceIdentifier{
+		{ID: "1"},
+		{UDID: "270aae10800b6e61a2ee2bbc285eb967050b5984"},
+		{MACAddress: "E0:AC:CB:97:36:G4"},
+	} {
+		device, err := j.GetMobileDevice(identifier)
+		assert.Nil(t, err)
+		info := device.Info
+		assert.Equal(t, 1, info.General.ID)
+		assert.Equal(t, "Test iPad #1", info.General.Name)
+		assert.Equal(t, "17.4", info.General.OSVersion)
+		assert.Equal(t, 256000, info.General.CapacityMB)
+		assert.Equal(t, 87, info.General.BatteryLevel)
+		assert.True(t, info.General.Supervised)
+		assert.Equal(t, "None", info.General.Site.Name)
+		assert.Equal(t, "Engineering", info.Location.Department)
+		assert.Equal(t, "com.datadoghq.app", info.Applications[0].Identifier)
+		assert.True(t, info.Security.PasscodeCompliant)
+		assert.Equal(t, 3, info.Security.HardwareEncryption)
+		assert.Equal(t, "35 123456 789012 3", info.Network.IMEI)
+		assert.Equal(t, "JSS Built-in Certificate Authority", info.Certificates[0].CommonName)
+		assert.Equal(t, "com.datadoghq.wifi", info.ConfigProfiles[0].Identifier)
+		assert.Equal(t, "All Managed iPads", info.Groups[0].Name)
+		assert.Equal(t, "IT", info.ExtensionAttributes[0].Value)
+	}
+
+	// XML
+	device, err := j.GetMobileDevice(&jamf.MobileDeviceIdentifier{ID: "3"})
+	assert.Nil(t, err)
+	info := device.Info
+	assert.Equal(t, 3, info.General.ID)
+	assert.Equal(t, "17.4", info.General.OSVersion)
+	assert.Equal(t, "C02Q7KHTGFWH", info.General.SerialNumber)
+	assert.Equal(t, "470aae10800b6e61a2ee2bbc285eb967050b5986", info.General.UDID)
+	assert.Equal(t, "E0:AC:CB:97:36:G5", info.General.WifiMACAddress)
+	assert.Equal(t, "iPhone15,4", info.General.ModelIdentifier)
+	assert.True(t, info.General.Managed)
+	assert.True(t, info.General.Supervised)
+	assert.Equal(t, []jamf.MobileDeviceApplication{{Name: "Datadog", Version: "3.1", Identifier: "com.datadoghq.app"}}, info.Applications)
+	assert.Equal(t, 3, info.Security.HardwareEncryption)
+	assert.Equal(t, "35 123456 789012 4", info.Network.IMEI)
+	assert.Equal(t, "JSS Built-in Certificate Authority", info.Certificates[0].CommonName)
+	assert.Equal(t, "com.datadoghq.wifi", info.ConfigProfiles[0].Identifier)
+	assert.Equal(t, []jamf.MobileDeviceGroupMembership{{ID: 3, Name: "All Managed iPhones"}}, info.Groups)
+
+	_, err = j.GetMobileDevice(&jamf.MobileDeviceIdentifier{SerialNumber: "UNKNOWN"})
+	assert.NotNil(t, err)
+}
+
+func TestGetMobileDeviceSubset(t *testing.T) {
+	testServer := mobileDeviceResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	device, err := j.GetMobileDeviceSubset(&jamf.MobileDeviceIdentifier{SerialNumber: "C02Q7KHTGFWF"}, jamf.MobileDeviceSectionGeneral, jamf.MobileDeviceSectionLocation)
+	assert.Nil(t, err)
+	assert.Equal(t, "A-1234", device.Info.General.AssetTag)
+	assert.Equal(t, "Engineering", device.Info.Location.Department)
+	assert.Empty(t, device.Info.Applications)
+
+	device, err = j.GetMobileDeviceSubset(&jamf.MobileDeviceIdentifier{Name: "Test iPad #2"}, jamf.MobileDeviceSectionExtensionAttributes)
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.ExtensionAttribute{{ID: 4, Name: "Owner Team", Type: "String", Value: "IT"}}, device.Info.ExtensionAttributes)
+
+	_, err = j.GetMobileDeviceSubset(&jamf.MobileDeviceIdentifier{ID: "1"})
+	assert.NotNil(t, err)
+}
+
+func TestUpdateMobileDevice(t *testing.T) {
+	testServer := mobileDeviceResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	updates := &jamf.MobileDeviceDetails{
+		General:  jamf.MobileDeviceGeneral{Name: "Renamed iPad", AssetTag: "A-5678"},
+		Location: jamf.MobileDeviceLocation{Username: "new.user"},
+		ExtensionAttributes: []jamf.ExtensionAttribute{
+			{ID: 4, Value: "Platform"},
+		},
+	}
+	updated, err := j.UpdateMobileDevice(&jamf.MobileDeviceIdentifier{ID: "1"}, updates)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, updated.ID)
+	assert.Equal(t, "Renamed iPad", updated.General.Name)
+	assert.Equal(t, "A-5678", updated.General.AssetTag)
+	assert.Equal(t, "new.user", updated.Location.Username)
+
+	// devices can be fetched, edited and sent back as is
+	device, err := j.GetMobileDevice(&jamf.MobileDeviceIdentifier{ID: "1"})
+	assert.Nil(t, err)
+	device.Info.General.Name = "Renamed iPad"
+	updated, err = j.UpdateMobileDevice(&jamf.MobileDeviceIdentifier{ID: "1"}, &device.Info)
+	assert.Nil(t, err)
+	assert.Equal(t, "Renamed iPad", updated.General.Name)
+	assert.Equal(t, "A-1234", updated.General.AssetTag)
+	assert.Equal(t, "test.user", updated.Location.Username)
+
+	updated, err = j.UpdateMobileDeviceExtensionAttributes(&jamf.MobileDeviceIdentifier{SerialNumber: "C02Q7KHTGFWG"}, []jamf.ExtensionAttribute{{ID: 4, Value: "Platform"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, updated.ID)
+
+	_, err = j.UpdateMobileDeviceExtensionAttributes(&jamf.MobileDeviceIdentifier{ID: "1"}, nil)
+	assert.NotNil(t, err)
+}
+
+func TestDeleteMobileDevice(t *testing.T) {
+	testServer := mobileDeviceResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	deleted, err := j.DeleteMobileDevice(&jamf.MobileDeviceIdentifier{ID: "1"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deleted.ID)
+}
+
+func TestMobileDeviceIdentifierRequired(t *testing.T) {
+	var requests int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+	}))
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	// requests without an identifier are never sent
+	_, err = j.GetMobileDevice(&jamf.MobileDeviceIdentifier{})
+	assert.NotNil(t, err)
+	_, err = j.GetMobileDeviceSubset(&jamf.MobileDeviceIdentifier{}, jamf.MobileDeviceSectionGeneral)
+	assert.NotNil(t, err)
+	_, err = j.UpdateMobileDevice(&jamf.MobileDeviceIdentifier{}, &jamf.MobileDeviceDetails{})
+	assert.NotNil(t, err)
+	_, err = j.DeleteMobileDevice(nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(0), requests)
+}
@@ -0,0 +1,216 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MobileConfig represents a parsed .mobileconfig payload embedded in a configuration profile
+type MobileConfig struct {
+	Identifier        string
+	UUID              string
+	Type              string
+	Version           int
+	DisplayName       string
+	Description       string
+	Organization      string
+	Scope             string
+	RemovalDisallowed bool
+	Payloads          []MobileConfigPayload
+	// Plist holds every key of the top level dictionary including the ones mapped above
+	Plist map[string]any
+}
+
+// MobileConfigPayload represents a single entry of a .mobileconfig PayloadContent array
+// i.e a Wi-Fi, restrictions or certificate payload
+type MobileConfigPayload struct {
+	Identifier  string
+	UUID        string
+	Type        string
+	Version     int
+	DisplayName string
+	// Settings holds every key of the payload dictionary including the ones mapped above
+	Settings map[string]any
+}
+
+// ParseMobileConfig parses the XML property list of a .mobileconfig payload. Values are decoded as
+// map[string]any for <dict>, []any for <array>, string, int64, float64, bool, time.Time and []byte
+func ParseMobileConfig(payload string) (*MobileConfig, error) {
+	value, err := parsePlist([]byte(payload))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse mobileconfig payload")
+	}
+
+	dict, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unable to parse mobileconfig payload: expected top level dict, got %T", value)
+	}
+
+	config := &MobileConfig{
+		Identifier:        plistString(dict, "PayloadIdentifier"),
+		UUID:              plistString(dict, "PayloadUUID"),
+		Type:              plistString(dict, "PayloadType"),
+		Version:           plistInt(dict, "PayloadVersion"),
+		DisplayName:       plistString(dict, "PayloadDisplayName"),
+		Description:       plistString(dict, "PayloadDescription"),
+		Organization:      plistString(dict, "PayloadOrganization"),
+		Scope:             plistString(dict, "PayloadScope"),
+		RemovalDisallowed: dict["PayloadRemovalDisallowed"] == true,
+		Plist:             dict,
+	}
+
+	content, _ := dict["PayloadContent"].([]any)
+	for _, c := range content {
+		settings, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		config.Payloads = append(config.Payloads, MobileConfigPayload{
+			Identifier:  plistString(settings, "PayloadIdentifier"),
+			UUID:        plistString(settings, "PayloadUUID"),
+			Type:        plistString(settings, "PayloadType"),
+			Version:     plistInt(settings, "PayloadVersion"),
+			DisplayName: plistString(settings, "PayloadDisplayName"),
+			Settings:    settings,
+		})
+	}
+
+	return config, nil
+}
+
+func plistString(dict map[string]any, key string) string {
+	s, _ := dict[key].(string)
+	return s
+}
+
+func plistInt(dict map[string]any, key string) int {
+	i, _ := dict[key].(int64)
+	return int(i)
+}
+
+// parsePlist decodes the root value of an XML property list
+func parsePlist(data []byte) (any, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	root, err := nextPlistElement(dec)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil || root.Name.Local != "plist" {
+		return nil, errors.New("payload is not an XML property list")
+	}
+
+	start, err := nextPlistElement(dec)
+	if err != nil {
+		return nil, err
+	}
+	if start == nil {
+		return nil, errors.New("property list is empty")
+	}
+	return decodePlistValue(dec, *start)
+}
+
+// nextPlistElement returns the next start element or nil once the enclosing element ends
+func nextPlistElement(dec *xml.Decoder) (*xml.StartElement, error) {
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			return &t, nil
+		case xml.EndElement:
+			return nil, nil
+		}
+	}
+}
+
+func decodePlistValue(dec *xml.Decoder, start xml.StartElement) (any, error) {
+	switch start.Name.Local {
+	case "dict":
+		dict := map[string]any{}
+		for {
+			key, err := nextPlistElement(dec)
+			if err != nil {
+				return nil, err
+			}
+			if key == nil {
+				return dict, nil
+			}
+			if key.Name.Local != "key" {
+				return nil, fmt.Errorf("expected <key> in dict, got <%s>", key.Name.Local)
+			}
+			var name string
+			if err := dec.DecodeElement(&name, key); err != nil {
+				return nil, err
+			}
+
+			valueStart, err := nextPlistElement(dec)
+			if err != nil {
+				return nil, err
+			}
+			if valueStart == nil {
+				return nil, fmt.Errorf("missing value for key %q", name)
+			}
+			value, err := decodePlistValue(dec, *valueStart)
+			if err != nil {
+				return nil, err
+			}
+			dict[name] = value
+		}
+	case "array":
+		array := []any{}
+		for {
+			valueStart, err := nextPlistElement(dec)
+			if err != nil {
+				return nil, err
+			}
+			if valueStart == nil {
+				return array, nil
+			}
+			value, err := decodePlistValue(dec, *valueStart)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+	case "true", "false":
+		if err := dec.Skip(); err != nil {
+			return nil, err
+		}
+		return start.Name.Local == "true", nil
+	}
+
+	var text string
+	if err := dec.DecodeElement(&text, &start); err != nil {
+		return nil, err
+	}
+
+	switch start.Name.Local {
+	case "string":
+		return text, nil
+	case "integer":
+		return strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+	case "real":
+		return strconv.ParseFloat(strings.TrimSpace(text), 64)
+	case "date":
+		return time.Parse(time.RFC3339, strings.TrimSpace(text))
+	case "data":
+		return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+	}
+	return nil, fmt.Errorf("unsupported property list element <%s>", start.Name.Local)
+}
@@ -0,0 +1,297 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/netip"
+
+	"github.com/pkg/errors"
+)
+
+// NetworkSegments returns a list of network segments available in Jamf
+func (j *Client) NetworkSegments() ([]NetworkSegment, error) {
+	return j.NetworkSegmentsContext(context.Background())
+}
+
+// NetworkSegmentsContext is like NetworkSegments but uses the given context for the request
+func (j *Client) NetworkSegmentsContext(ctx context.Context) ([]NetworkSegment, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, networkSegmentsContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf network segments query request")
+	}
+	res := NetworkSegments{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available network segments from %s", ep)
+	}
+	return res.List, nil
+}
+
+// NetworkSegmentDetails returns the details for a specific network segment given its ID or Name
+func (j *Client) NetworkSegmentDetails(identifier any) (*NetworkSegmentDetails, error) {
+	return j.NetworkSegmentDetailsContext(context.Background(), identifier)
+}
+
+// NetworkSegmentDetailsContext is like NetworkSegmentDetails but uses the given context for the request
+func (j *Client) NetworkSegmentDetailsContext(ctx context.Context, identifier any) (*NetworkSegmentDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, networkSegmentsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for network segment: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for network segment: %v", identifier)
+	}
+
+	res := networkSegmentResponse{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query network segment: %v from %s", identifier, ep)
+	}
+	return &res.Details, nil
+}
+
+// UpdateNetworkSegment will update a network segment in Jamf by either ID or Name
+func (j *Client) UpdateNetworkSegment(identifier any, segment *NetworkSegmentDetails) (*NetworkSegmentDetails, error) {
+	return j.UpdateNetworkSegmentContext(context.Background(), identifier, segment)
+}
+
+// UpdateNetworkSegmentContext is like UpdateNetworkSegment but uses the given context for the request
+func (j *Client) UpdateNetworkSegmentContext(ctx context.Context, identifier any, segment *NetworkSegmentDetails) (*NetworkSegmentDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, networkSegmentsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for network segment: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(segment)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for network segment: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for network segment: %v (%s)", identifier, ep)
+	}
+
+	res := NetworkSegmentDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for network segment: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateNetworkSegment will create a network segment in Jamf
+func (j *Client) CreateNetworkSegment(segment *NetworkSegmentDetails) (*NetworkSegmentDetails, error) {
+	return j.CreateNetworkSegmentContext(context.Background(), segment)
+}
+
+// CreateNetworkSegmentContext is like CreateNetworkSegment but uses the given context for the request
+func (j *Client) CreateNetworkSegmentContext(ctx context.Context, segment *NetworkSegmentDetails) (*NetworkSegmentDetails, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, networkSegmentsContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF query request for new network segment")
+	}
+
+	if segment.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new network segment"), "unable to process JAMF creation request for network segment: (%s)", ep)
+	}
+
+	if _, _, err := segment.Range(); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for network segment: %v (%s)", segment.Name, ep)
+	}
+
+	bodyContent, err := xml.Marshal(segment)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for network segment: %v", segment.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for network segment: %v (%s)", segment.Name, ep)
+	}
+
+	res := NetworkSegmentDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for network segment: %v (%s)", segment.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteNetworkSegment will delete a network segment by either ID or Name
+func (j *Client) DeleteNetworkSegment(identifier any) (*NetworkSegmentDetails, error) {
+	return j.DeleteNetworkSegmentContext(context.Background(), identifier)
+}
+
+// DeleteNetworkSegmentContext is like DeleteNetworkSegment but uses the given context for the request
+func (j *Client) DeleteNetworkSegmentContext(ctx context.Context, identifier any) (*NetworkSegmentDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, networkSegmentsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for network segment: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for network segment: %v (%s)", identifier, ep)
+	}
+
+	res := NetworkSegmentDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for network segment: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// NetworkSegmentsForIP returns the network segments in Jamf whose address range contains the given IP
+// address, i.e GeneralInformation.LastReportedIP
+func (j *Client) NetworkSegmentsForIP(ip string) ([]NetworkSegment, error) {
+	return j.NetworkSegmentsForIPContext(context.Background(), ip)
+}
+
+// NetworkSegmentsForIPContext is like NetworkSegmentsForIP but uses the given context for the request
+func (j *Client) NetworkSegmentsForIPContext(ctx context.Context, ip string) ([]NetworkSegment, error) {
+	segments, err := j.NetworkSegmentsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MatchNetworkSegments(segments, ip)
+}
+
+// OverlappingNetworkSegments returns the network segments in Jamf whose address range overlaps the given
+// segment so it can be validated before it is created or updated. The segment itself is skipped by ID as
+// are existing segments with a malformed address range
+func (j *Client) OverlappingNetworkSegments(segment NetworkSegment) ([]NetworkSegment, error) {
+	return j.OverlappingNetworkSegmentsContext(context.Background(), segment)
+}
+
+// OverlappingNetworkSegmentsContext is like OverlappingNetworkSegments but uses the given context for the request
+func (j *Client) OverlappingNetworkSegmentsContext(ctx context.Context, segment NetworkSegment) ([]NetworkSegment, error) {
+	segments, err := j.NetworkSegmentsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := segment.Range(); err != nil {
+		return nil, err
+	}
+
+	overlapping := []NetworkSegment{}
+	for _, existing := range segments {
+		if segment.ID > 0 && existing.ID == segment.ID {
+			continue
+		}
+		overlaps, err := segment.Overlaps(existing)
+		if err != nil {
+			continue
+		}
+		if overlaps {
+			overlapping = append(overlapping, existing)
+		}
+	}
+	return overlapping, nil
+}
+
+// Range returns the first and last address of the network segment. Jamf only supports IPv4 network segments
+func (s NetworkSegment) Range() (netip.Addr, netip.Addr, error) {
+	start, err := parseIPv4(s.StartingAddress)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, errors.Wrapf(err, "invalid starting address for network segment: %s", s.Name)
+	}
+	end, err := parseIPv4(s.EndingAddress)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, errors.Wrapf(err, "invalid ending address for network segment: %s", s.Name)
+	}
+	if end.Less(start) {
+		return netip.Addr{}, netip.Addr{}, errors.Errorf("starting address %s is after ending address %s for network segment: %s", start, end, s.Name)
+	}
+	return start, end, nil
+}
+
+// Contains reports whether the given IP address is within the network segment, IPv6 addresses
+// are never contained in a segment
+func (s NetworkSegment) Contains(ip string) (bool, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid IP address: %s", ip)
+	}
+	start, end, err := s.Range()
+	if err != nil {
+		return false, err
+	}
+	addr = addr.Unmap()
+	return addr.Is4() && !addr.Less(start) && !end.Less(addr), nil
+}
+
+// Overlaps reports whether the address ranges of two network segments overlap
+func (s NetworkSegment) Overlaps(other NetworkSegment) (bool, error) {
+	start, end, err := s.Range()
+	if err != nil {
+		return false, err
+	}
+	otherStart, otherEnd, err := other.Range()
+	if err != nil {
+		return false, err
+	}
+	return !end.Less(otherStart) && !otherEnd.Less(start), nil
+}
+
+// MatchNetworkSegments returns the segments whose address range contains the given IP address
+// without making any requests to Jamf. Segments with a malformed address range never match
+func MatchNetworkSegments(segments []NetworkSegment, ip string) ([]NetworkSegment, error) {
+	if _, err := netip.ParseAddr(ip); err != nil {
+		return nil, errors.Wrapf(err, "invalid IP address: %s", ip)
+	}
+
+	matches := []NetworkSegment{}
+	for _, segment := range segments {
+		contains, err := segment.Contains(ip)
+		if err != nil {
+			// a single misconfigured segment must not break the lookup for every address
+			continue
+		}
+		if contains {
+			matches = append(matches, segment)
+		}
+	}
+	return matches, nil
+}
+
+// FindNetworkSegmentOverlaps returns each pair of segments whose address ranges overlap
+func FindNetworkSegmentOverlaps(segments []NetworkSegment) ([]NetworkSegmentOverlap, error) {
+	overlaps := []NetworkSegmentOverlap{}
+	for i := range segments {
+		for _, other := range segments[i+1:] {
+			overlap, err := segments[i].Overlaps(other)
+			if err != nil {
+				return nil, err
+			}
+			if overlap {
+				overlaps = append(overlaps, NetworkSegmentOverlap{First: segments[i], Second: other})
+			}
+		}
+	}
+	return overlaps, nil
+}
+
+func parseIPv4(address string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	addr = addr.Unmap()
+	if !addr.Is4() {
+		return netip.Addr{}, errors.Errorf("%s is not an IPv4 address", address)
+	}
+	return addr, nil
+}
@@ -0,0 +1,44 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "encoding/xml"
+
+// NetworkSegments represents a list of network segments in Jamf
+type NetworkSegments struct {
+	List []NetworkSegment `json:"network_segments" xml:"network_segment,omitempty"`
+	Size int              `json:"size" xml:"size"`
+}
+
+// NetworkSegmentDetails holds the details of a network segment configured in Jamf including the
+// distribution point, software update server, building and department used by devices in its range
+type NetworkSegmentDetails struct {
+	XMLName xml.Name `json:"-" xml:"network_segment,omitempty"`
+	NetworkSegment
+	DistributionServer  string `json:"distribution_server" xml:"distribution_server,omitempty"`
+	DistributionPoint   string `json:"distribution_point" xml:"distribution_point,omitempty"`
+	URL                 string `json:"url" xml:"url,omitempty"`
+	SWUServer           string `json:"swu_server" xml:"swu_server,omitempty"`
+	Building            string `json:"building" xml:"building,omitempty"`
+	Department          string `json:"department" xml:"department,omitempty"`
+	OverrideBuildings   bool   `json:"override_buildings" xml:"override_buildings"`
+	OverrideDepartments bool   `json:"override_departments" xml:"override_departments"`
+}
+
+// NetworkSegmentOverlap holds two network segments whose address ranges overlap
+type NetworkSegmentOverlap struct {
+	First  NetworkSegment
+	Second NetworkSegment
+}
+
+// networkSegmentResponse unwraps the network segment returned by the classic API
+type networkSegmentResponse struct {
+	Details NetworkSegmentDetails `json:"network_segment"`
+}
+
+// UnmarshalXML decodes the network_segment element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (n *networkSegmentResponse) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&n.Details, &start)
+}
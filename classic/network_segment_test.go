@@ -0,0 +1,247 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var NETWORK_SEGMENTS_BASE_API_ENDPOINT = "/JSSResource/networksegments"
+
+func networkSegmentsResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case NETWORK_SEGMENTS_BASE_API_ENDPOINT:
+			fmt.Fprint(w, `{
+				"network_segments": [
+					{
+						"id": 1,
+						"name": "Boston Office",
+						"starting_address": "10.1.0.0",
+						"ending_address": "10.1.255.255"
+					},
+					{
+						"id": 2,
+						"name": "Boston Guest Wi-Fi",
+						"starting_address": "10.1.200.0",
+						"ending_address": "10.1.200.255"
+					},
+					{
+						"id": 3,
+						"name": "New York Office",
+						"starting_address": "10.2.0.0",
+						"ending_address": "10.2.255.255"
+					},
+					{
+						"id": 4,
+						"name": "Misconfigured",
+						"starting_address": "10.1.0.0",
+						"ending_address": ""
+					}]
+				}`)
+		case fmt.Sprintf("%s/id/1", NETWORK_SEGMENTS_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{
+					"network_segment": {
+						"id": 1,
+						"name": "Boston Office",
+						"starting_address": "10.1.0.0",
+						"ending_address": "10.1.255.255",
+						"distribution_server": "",
+						"distribution_point": "Boston DP",
+						"url": "https://dp.boston.example.com/CasperShare",
+						"swu_server": "",
+						"building": "Boston",
+						"department": "",
+						"override_buildings": true,
+						"override_departments": false
+					}
+				}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				segment := &jamf.NetworkSegmentDetails{}
+				assert.Nil(t, xml.Unmarshal(data, segment))
+				assert.Equal(t, "10.1.255.254", segment.EndingAddress)
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><network_segment><id>1</id></network_segment>`)
+			case "DELETE":
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><network_segment><id>1</id></network_segment>`)
+			}
+		case fmt.Sprintf("%s/name/New%%20York%%20Office", NETWORK_SEGMENTS_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<network_segment>
+					<id>3</id>
+					<name>New York Office</name>
+					<starting_address>10.2.0.0</starting_address>
+					<ending_address>10.2.255.255</ending_address>
+					<distribution_point>New York DP</distribution_point>
+					<building>New York</building>
+					<override_buildings>false</override_buildings>
+					<override_departments>false</override_departments>
+				</network_segment>`)
+		case fmt.Sprintf("%s/id/-1", NETWORK_SEGMENTS_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, `<network_segment><name>Paris Office</name><starting_address>10.3.0.0</starting_address><ending_address>10.3.255.255</ending_address><override_buildings>false</override_buildings><override_departments>false</override_departments></network_segment>`, string(data))
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><network_segment><id>4</id></network_segment>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf network segments API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestNetworkSegments(t *testing.T) {
+	testServer := networkSegmentsResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	segments, err := j.NetworkSegments()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(segments))
+	assert.Equal(t, "10.1.200.0", segments[1].StartingAddress)
+
+	segment, err := j.NetworkSegmentDetails(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "Boston Office", segment.Name)
+	assert.Equal(t, "10.1.255.255", segment.EndingAddress)
+	assert.Equal(t, "Boston DP", segment.DistributionPoint)
+	assert.Equal(t, true, segment.OverrideBuildings)
+
+	segment, err = j.NetworkSegmentDetails("New York Office")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, segment.ID)
+	assert.Equal(t, "10.2.0.0", segment.StartingAddress)
+	assert.Equal(t, "New York", segment.Building)
+}
+
+func TestCreateUpdateDeleteNetworkSegment(t *testing.T) {
+	testServer := networkSegmentsResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	_, err = j.CreateNetworkSegment(&jamf.NetworkSegmentDetails{NetworkSegment: jamf.NetworkSegment{StartingAddress: "10.3.0.0", EndingAddress: "10.3.255.255"}})
+	assert.NotNil(t, err)
+
+	// ranges are validated before the request is made
+	_, err = j.CreateNetworkSegment(&jamf.NetworkSegmentDetails{NetworkSegment: jamf.NetworkSegment{Name: "Paris Office", StartingAddress: "10.3.255.255", EndingAddress: "10.3.0.0"}})
+	assert.NotNil(t, err)
+	_, err = j.CreateNetworkSegment(&jamf.NetworkSegmentDetails{NetworkSegment: jamf.NetworkSegment{Name: "Paris Office", StartingAddress: "fd00::1", EndingAddress: "fd00::ff"}})
+	assert.NotNil(t, err)
+
+	segment, err := j.CreateNetworkSegment(&jamf.NetworkSegmentDetails{NetworkSegment: jamf.NetworkSegment{Name: "Paris Office", StartingAddress: "10.3.0.0", EndingAddress: "10.3.255.255"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, segment.ID)
+
+	segment, err = j.UpdateNetworkSegment(1, &jamf.NetworkSegmentDetails{NetworkSegment: jamf.NetworkSegment{EndingAddress: "10.1.255.254"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, segment.ID)
+
+	segment, err = j.DeleteNetworkSegment(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, segment.ID)
+}
+
+func TestNetworkSegmentsForIP(t *testing.T) {
+	testServer := networkSegmentsResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	segments, err := j.NetworkSegmentsForIP("10.1.200.17")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(segments))
+	assert.Equal(t, "Boston Office", segments[0].Name)
+	assert.Equal(t, "Boston Guest Wi-Fi", segments[1].Name)
+
+	segments, err = j.NetworkSegmentsForIP("192.168.1.10")
+	assert.Nil(t, err)
+	assert.Empty(t, segments)
+
+	_, err = j.NetworkSegmentsForIP("not-an-ip")
+	assert.NotNil(t, err)
+
+	overlapping, err := j.OverlappingNetworkSegments(jamf.NetworkSegment{ID: 1, Name: "Boston Office", StartingAddress: "10.1.0.0", EndingAddress: "10.2.0.0"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(overlapping))
+	assert.Equal(t, 2, overlapping[0].ID)
+	assert.Equal(t, 3, overlapping[1].ID)
+
+	_, err = j.OverlappingNetworkSegments(jamf.NetworkSegment{Name: "Broken", StartingAddress: "10.1.0.0"})
+	assert.NotNil(t, err)
+}
+
+func TestMatchNetworkSegments(t *testing.T) {
+	segments := []jamf.NetworkSegment{
+		{ID: 1, Name: "Office", StartingAddress: "10.0.0.0", EndingAddress: "10.0.255.255"},
+		{ID: 2, Name: "VPN", StartingAddress: "10.0.255.0", EndingAddress: "10.1.0.255"},
+		{ID: 3, Name: "Lab", StartingAddress: "172.16.0.1", EndingAddress: "172.16.0.1"},
+	}
+
+	matches, err := jamf.MatchNetworkSegments(segments, "10.0.0.0")
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.NetworkSegment{segments[0]}, matches)
+
+	matches, err = jamf.MatchNetworkSegments(segments, "10.0.255.255")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(matches))
+
+	matches, err = jamf.MatchNetworkSegments(segments, "::ffff:172.16.0.1")
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.NetworkSegment{segments[2]}, matches)
+
+	// IPv6 addresses never match
+	matches, err = jamf.MatchNetworkSegments(segments, "fd00::1")
+	assert.Nil(t, err)
+	assert.Empty(t, matches)
+
+	// segments with a malformed address range are skipped rather than failing the lookup
+	broken := append([]jamf.NetworkSegment{
+		{Name: "Broken", StartingAddress: "10.0.0.1"},
+		{Name: "Reversed", StartingAddress: "10.0.0.9", EndingAddress: "10.0.0.1"},
+	}, segments...)
+	matches, err = jamf.MatchNetworkSegments(broken, "10.0.0.1")
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.NetworkSegment{segments[0]}, matches)
+
+	_, err = jamf.MatchNetworkSegments(segments, "not-an-ip")
+	assert.NotNil(t, err)
+}
+
+func TestFindNetworkSegmentOverlaps(t *testing.T) {
+	segments := []jamf.NetworkSegment{
+		{ID: 1, Name: "Office", StartingAddress: "10.0.0.0", EndingAddress: "10.0.255.255"},
+		{ID: 2, Name: "VPN", StartingAddress: "10.0.255.255", EndingAddress: "10.1.0.255"},
+		{ID: 3, Name: "Lab", StartingAddress: "10.1.1.0", EndingAddress: "10.1.1.255"},
+		{ID: 4, Name: "Printers", StartingAddress: "10.0.10.0", EndingAddress: "10.0.10.255"},
+	}
+
+	overlaps, err := jamf.FindNetworkSegmentOverlaps(segments)
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.NetworkSegmentOverlap{
+		{First: segments[0], Second: segments[1]},
+		{First: segments[0], Second: segments[3]},
+	}, overlaps)
+
+	overlaps, err = jamf.FindNetworkSegmentOverlaps(segments[1:3])
+	assert.Nil(t, err)
+	assert.Empty(t, overlaps)
+
+	_, err = jamf.FindNetworkSegmentOverlaps([]jamf.NetworkSegment{segments[0], {Name: "Backwards", StartingAddress: "10.0.0.9", EndingAddress: "10.0.0.1"}})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,79 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// oauthCredentials holds the Jamf Pro API client credentials
+// https://developer.jamf.com/jamf-pro/docs/client-credentials
+type oauthCredentials struct {
+	clientID     string
+	clientSecret string
+}
+
+// OAuthToken represents the access token returned by the Jamf Pro API client credentials flow
+type OAuthToken struct {
+	AccessToken string `json:"access_token"`
+	Scope       string `json:"scope"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// authToken converts the access token into an AuthToken expiring relative to the given time
+func (t *OAuthToken) authToken(issued time.Time) *AuthToken {
+	return &AuthToken{
+		Token:   t.AccessToken,
+		Expires: issued.Add(time.Duration(t.ExpiresIn) * time.Second).Format(time.RFC3339),
+	}
+}
+
+// getOAuthToken retrieves an access token using the API client credentials
+func (j *Client) getOAuthToken(ctx context.Context) (*AuthToken, error) {
+	ep := fmt.Sprintf("%s/api/oauth/token", j.Domain)
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", j.oauth.clientID)
+	form.Set("client_secret", j.oauth.clientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	issued := time.Now()
+	// fetching a token has no side effects so it is safe to retry
+	res, err := j.do(req, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error making %s request to %s", req.Method, req.URL)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		responseData, _ := io.ReadAll(res.Body)
+		return nil, errors.Wrapf(newAPIError(res, responseData), "error making %s request to %s", req.Method, req.URL)
+	}
+
+	var token OAuthToken
+	if err = json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return nil, errors.Wrap(err, "response was successful but error occurred error decoding response body")
+	}
+	if token.AccessToken == "" {
+		return nil, errors.Errorf("response from %s did not include an access token", req.URL)
+	}
+
+	return token.authToken(issued), nil
+}
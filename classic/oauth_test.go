@@ -0,0 +1,104 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func oauthResponseMocks(t *testing.T, expiresIn int) (*httptest.Server, *int32) {
+	var tokenRequests int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/oauth/token":
+			n := atomic.AddInt32(&tokenRequests, 1)
+			assert.Nil(t, r.ParseForm())
+			assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+			if r.PostForm.Get("client_id") != "mock-client-id" || r.PostForm.Get("client_secret") != "mock-client-secret" {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"error": "invalid_client"}`)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{
+				"access_token": "mock-access-token-%d",
+				"scope": "api-role:1",
+				"token_type": "Bearer",
+				"expires_in": %d
+			}`, n, expiresIn)
+		case "/JSSResource/mock/test":
+			_, _, basic := r.BasicAuth()
+			assert.False(t, basic)
+			fmt.Fprintf(w, `{"status": "%s"}`, r.Header.Get("Authorization"))
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	})), &tokenRequests
+}
+
+func TestOAuthClientCredentials(t *testing.T) {
+	server, tokenRequests := oauthResponseMocks(t, 300)
+	defer server.Close()
+
+	j, err := jamf.NewClient(server.URL, "", "", server.Client(), jamf.WithOAuthClientCredentials("mock-client-id", "mock-client-secret"))
+	assert.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/mock/test", j.Endpoint), nil)
+		assert.Nil(t, err)
+		statusResponse := &MockResponse{}
+		_, err = j.MockAPIRequest(req, statusResponse)
+		assert.Nil(t, err)
+		assert.Equal(t, "Bearer mock-access-token-1", statusResponse.Status)
+	}
+
+	// the cached token is reused until it is about to expire
+	assert.Equal(t, int32(1), atomic.LoadInt32(tokenRequests))
+	expired, err := j.AuthToken().IsExpired()
+	assert.Nil(t, err)
+	assert.False(t, expired)
+}
+
+func TestOAuthClientCredentialsRefresh(t *testing.T) {
+	// tokens expiring within the refresh window are renewed before each request
+	server, tokenRequests := oauthResponseMocks(t, 10)
+	defer server.Close()
+
+	j, err := jamf.NewClient(server.URL, "", "", server.Client(), jamf.WithOAuthClientCredentials("mock-client-id", "mock-client-secret"))
+	assert.Nil(t, err)
+
+	for i := 1; i <= 5; i++ {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/mock/test", j.Endpoint), nil)
+		assert.Nil(t, err)
+		statusResponse := &MockResponse{}
+		_, err = j.MockAPIRequest(req, statusResponse)
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("Bearer mock-access-token-%d", i), statusResponse.Status)
+	}
+	assert.Equal(t, int32(5), atomic.LoadInt32(tokenRequests))
+}
+
+func TestOAuthClientCredentialsInvalid(t *testing.T) {
+	server, _ := oauthResponseMocks(t, 300)
+	defer server.Close()
+
+	j, err := jamf.NewClient(server.URL, "", "", server.Client(), jamf.WithOAuthClientCredentials("mock-client-id", "wrong-secret"))
+	assert.Nil(t, err)
+	err = j.GetAuthToken()
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, jamf.ErrUnauthorized))
+
+	_, err = jamf.NewClient(server.URL, "", "", server.Client(), jamf.WithOAuthClientCredentials("", "mock-client-secret"))
+	assert.NotNil(t, err)
+	assert.Equal(t, "you must provide a valid Jamf API client ID and client secret", err.Error())
+}
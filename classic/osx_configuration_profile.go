@@ -0,0 +1,183 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// OSXConfigurationProfiles returns a list of macOS configuration profiles available in Jamf
+func (j *Client) OSXConfigurationProfiles() ([]BasicOSXConfigurationProfileInfo, error) {
+	return j.OSXConfigurationProfilesContext(context.Background())
+}
+
+// OSXConfigurationProfilesContext is like OSXConfigurationProfiles but uses the given context for the request
+func (j *Client) OSXConfigurationProfilesContext(ctx context.Context) ([]BasicOSXConfigurationProfileInfo, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, osxConfigurationProfilesContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf configuration profiles query request")
+	}
+	res := OSXConfigurationProfiles{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available configuration profiles from %s", ep)
+	}
+	return filterBySite(ctx, j, res.List, func(ctx context.Context, item BasicOSXConfigurationProfileInfo) error {
+		_, err := j.OSXConfigurationProfileDetailsContext(ctx, item.ID)
+		return err
+	})
+}
+
+// OSXConfigurationProfileDetails returns the details for a specific macOS configuration profile given its ID or Name
+func (j *Client) OSXConfigurationProfileDetails(identifier any) (*OSXConfigurationProfile, error) {
+	return j.OSXConfigurationProfileDetailsContext(context.Background(), identifier)
+}
+
+// OSXConfigurationProfileDetailsContext is like OSXConfigurationProfileDetails but uses the given context for the request
+func (j *Client) OSXConfigurationProfileDetailsContext(ctx context.Context, identifier any) (*OSXConfigurationProfile, error) {
+	ep, err := EndpointBuilder(j.Endpoint, osxConfigurationProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for configuration profile: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for configuration profile: %v", identifier)
+	}
+
+	res := OSXConfigurationProfile{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query configuration profile: %v from %s", identifier, ep)
+	}
+	var site *Site
+	if res.Content.General != nil {
+		site = res.Content.General.Site
+	}
+	if err := j.checkSite(site); err != nil {
+		return nil, errors.Wrapf(err, "unable to query configuration profile: %v from %s", identifier, ep)
+	}
+	return &res, nil
+}
+
+// UpdateOSXConfigurationProfile will update a macOS configuration profile in Jamf by either ID or Name
+func (j *Client) UpdateOSXConfigurationProfile(identifier any, profile *OSXConfigurationProfileContents) (*OSXConfigurationProfileContents, error) {
+	return j.UpdateOSXConfigurationProfileContext(context.Background(), identifier, profile)
+}
+
+// UpdateOSXConfigurationProfileContext is like UpdateOSXConfigurationProfile but uses the given context for the request
+func (j *Client) UpdateOSXConfigurationProfileContext(ctx context.Context, identifier any, profile *OSXConfigurationProfileContents) (*OSXConfigurationProfileContents, error) {
+	ep, err := EndpointBuilder(j.Endpoint, osxConfigurationProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for configuration profile: %v", identifier)
+	}
+
+	if err := checkExistingSite(ctx, j, identifier, j.OSXConfigurationProfileDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for configuration profile: %v (%s)", identifier, ep)
+	}
+	// profiles can't be moved out of the site the client is pinned to
+	if profile.General != nil {
+		if _, err := j.clientSite(profile.General.Site); err != nil {
+			return nil, errors.Wrapf(err, "unable to process JAMF update request for configuration profile: %v (%s)", identifier, ep)
+		}
+	}
+
+	bodyContent, err := xml.Marshal(profile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for configuration profile: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for configuration profile: %v (%s)", identifier, ep)
+	}
+
+	res := OSXConfigurationProfileContents{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for configuration profile: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateOSXConfigurationProfile will create a macOS configuration profile in Jamf
+func (j *Client) CreateOSXConfigurationProfile(profile *OSXConfigurationProfileContents) (*OSXConfigurationProfileContents, error) {
+	return j.CreateOSXConfigurationProfileContext(context.Background(), profile)
+}
+
+// CreateOSXConfigurationProfileContext is like CreateOSXConfigurationProfile but uses the given context for the request
+func (j *Client) CreateOSXConfigurationProfileContext(ctx context.Context, profile *OSXConfigurationProfileContents) (*OSXConfigurationProfileContents, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, osxConfigurationProfilesContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF query request for new configuration profile")
+	}
+
+	if profile.General == nil || profile.General.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new configuration profile"), "unable to process JAMF creation request for configuration profile: (%s)", ep)
+	}
+
+	site, err := j.clientSite(profile.General.Site)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for configuration profile: %v (%s)", profile.General.Name, ep)
+	}
+	general := *profile.General
+	general.Site = site
+	content := *profile
+	content.General = &general
+	profile = &content
+
+	bodyContent, err := xml.Marshal(profile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for configuration profile: %v", profile.General.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for configuration profile: %v (%s)", profile.General.Name, ep)
+	}
+
+	res := OSXConfigurationProfileContents{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for configuration profile: %v (%s)", profile.General.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteOSXConfigurationProfile will delete a macOS configuration profile by either ID or Name
+func (j *Client) DeleteOSXConfigurationProfile(identifier any) (*OSXConfigurationProfileContents, error) {
+	return j.DeleteOSXConfigurationProfileContext(context.Background(), identifier)
+}
+
+// DeleteOSXConfigurationProfileContext is like DeleteOSXConfigurationProfile but uses the given context for the request
+func (j *Client) DeleteOSXConfigurationProfileContext(ctx context.Context, identifier any) (*OSXConfigurationProfileContents, error) {
+	ep, err := EndpointBuilder(j.Endpoint, osxConfigurationProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for configuration profile: %v", identifier)
+	}
+
+	if err := checkExistingSite(ctx, j, identifier, j.OSXConfigurationProfileDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for configuration profile: %v (%s)", identifier, ep)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for configuration profile: %v (%s)", identifier, ep)
+	}
+
+	res := OSXConfigurationProfileContents{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for configuration profile: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,61 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "encoding/xml"
+
+// OSXConfigurationProfiles represents a list of macOS configuration profiles in Jamf
+type OSXConfigurationProfiles struct {
+	List []BasicOSXConfigurationProfileInfo `json:"os_x_configuration_profiles" xml:"os_x_configuration_profile,omitempty"`
+	Size int                                `json:"size" xml:"size"`
+}
+
+// BasicOSXConfigurationProfileInfo represents the information returned in a list of all
+// macOS configuration profiles from Jamf
+type BasicOSXConfigurationProfileInfo struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name"`
+}
+
+// OSXConfigurationProfile represents a single macOS configuration profile in Jamf
+type OSXConfigurationProfile struct {
+	Content OSXConfigurationProfileContents `json:"os_x_configuration_profile" xml:"os_x_configuration_profile,omitempty"`
+}
+
+// UnmarshalXML decodes the os_x_configuration_profile element returned when the classic API responds
+// with XML since unlike the JSON response it isn't wrapped in a parent object
+func (p *OSXConfigurationProfile) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&p.Content, &start)
+}
+
+// OSXConfigurationProfileContents holds the details associated with a given macOS configuration profile.
+// ID is only populated from create, update and delete responses which Jamf returns as a bare ID
+type OSXConfigurationProfileContents struct {
+	XMLName     xml.Name                        `json:"-" xml:"os_x_configuration_profile,omitempty"`
+	ID          int                             `json:"-" xml:"id,omitempty"`
+	General     *OSXConfigurationProfileGeneral `json:"general" xml:"general,omitempty"`
+	Scope       *Scope                          `json:"scope" xml:"scope,omitempty"`
+	SelfService *SelfService                    `json:"self_service" xml:"self_service,omitempty"`
+}
+
+// OSXConfigurationProfileGeneral holds the general settings of a macOS configuration profile.
+// Payloads holds the raw .mobileconfig property list, use MobileConfig to access its parsed contents
+type OSXConfigurationProfileGeneral struct {
+	ID                 int             `json:"id,omitempty" xml:"id,omitempty"`
+	Name               string          `json:"name" xml:"name,omitempty"`
+	Description        string          `json:"description" xml:"description,omitempty"`
+	Site               *Site           `json:"site,omitempty" xml:"site,omitempty"`
+	Category           *PolicyCategory `json:"category,omitempty" xml:"category,omitempty"`
+	DistributionMethod string          `json:"distribution_method" xml:"distribution_method,omitempty"`
+	UserRemovable      bool            `json:"user_removable" xml:"user_removable"`
+	Level              string          `json:"level" xml:"level,omitempty"`
+	UUID               string          `json:"uuid" xml:"uuid,omitempty"`
+	RedeployOnUpdate   string          `json:"redeploy_on_update" xml:"redeploy_on_update,omitempty"`
+	Payloads           string          `json:"payloads" xml:"payloads,omitempty"`
+}
+
+// MobileConfig parses the .mobileconfig property list held in Payloads
+func (g *OSXConfigurationProfileGeneral) MobileConfig() (*MobileConfig, error) {
+	return ParseMobileConfig(g.Payloads)
+}
@@ -0,0 +1,256 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var OSX_CONFIG_PROFILES_BASE_API_ENDPOINT = "/JSSResource/osxconfigurationprofiles"
+
+var testMobileConfig = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1">
+<dict>
+	<key>PayloadUUID</key>
+	<string>6A5C9F3E-8B2D-4E1A-9C7F-0D3B5E7A9C11</string>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+	<key>PayloadOrganization</key>
+	<string>Datadog</string>
+	<key>PayloadIdentifier</key>
+	<string>6A5C9F3E-8B2D-4E1A-9C7F-0D3B5E7A9C11</string>
+	<key>PayloadDisplayName</key>
+	<string>Screen Saver</string>
+	<key>PayloadScope</key>
+	<string>System</string>
+	<key>PayloadRemovalDisallowed</key>
+	<true/>
+	<key>PayloadVersion</key>
+	<integer>1</integer>
+	<key>PayloadContent</key>
+	<array>
+		<dict>
+			<key>PayloadType</key>
+			<string>com.apple.screensaver</string>
+			<key>PayloadUUID</key>
+			<string>1B8E4D2C-3F6A-4B9E-8D1C-7E5F2A4B6C88</string>
+			<key>PayloadIdentifier</key>
+			<string>com.apple.screensaver.1B8E4D2C</string>
+			<key>PayloadVersion</key>
+			<integer>1</integer>
+			<key>idleTime</key>
+			<integer>600</integer>
+			<key>askForPasswordDelay</key>
+			<real>5.5</real>
+			<key>askForPassword</key>
+			<false/>
+			<key>modules</key>
+			<array>
+				<string>Flurry</string>
+			</array>
+			<key>expires</key>
+			<date>2030-01-02T03:04:05Z</date>
+			<key>icon</key>
+			<data>
+			aGVsbG8=
+			</data>
+		</dict>
+	</array>
+</dict>
+</plist>`
+
+func osxConfigurationProfilesResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case OSX_CONFIG_PROFILES_BASE_API_ENDPOINT:
+			fmt.Fprint(w, `{
+				"os_x_configuration_profiles": [
+					{
+						"id": 1,
+						"name": "Screen Saver"
+					},
+					{
+						"id": 2,
+						"name": "FileVault"
+					}]
+				}`)
+		case fmt.Sprintf("%s/id/1", OSX_CONFIG_PROFILES_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				payloads, err := json.Marshal(testMobileConfig)
+				assert.Nil(t, err)
+				fmt.Fprintf(w, `{
+					"os_x_configuration_profile": {
+						"general": {
+							"id": 1,
+							"name": "Screen Saver",
+							"description": "Locks the screen when idle",
+							"site": {"id": -1, "name": "None"},
+							"category": {"id": 3, "name": "Security"},
+							"distribution_method": "Install Automatically",
+							"user_removable": false,
+							"level": "System",
+							"uuid": "6A5C9F3E-8B2D-4E1A-9C7F-0D3B5E7A9C11",
+							"redeploy_on_update": "Newly Assigned",
+							"payloads": %s
+						},
+						"scope": {
+							"all_computers": false,
+							"computers": [{"id": 82, "name": "Go Client Test Machine"}],
+							"computer_groups": [{"id": 7, "name": "All Managed Clients"}]
+						},
+						"self_service": {
+							"self_service_display_name": "Screen Saver",
+							"install_button_text": "Install"
+						}
+					}
+				}`, payloads)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				profile := &jamf.OSXConfigurationProfileContents{}
+				assert.Nil(t, xml.Unmarshal(data, profile))
+				assert.Equal(t, "Screen Saver (Updated)", profile.General.Name)
+				assert.Equal(t, testMobileConfig, profile.General.Payloads)
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><os_x_configuration_profile><id>1</id></os_x_configuration_profile>`)
+			case "DELETE":
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><os_x_configuration_profile><id>1</id></os_x_configuration_profile>`)
+			}
+		case fmt.Sprintf("%s/name/FileVault", OSX_CONFIG_PROFILES_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<os_x_configuration_profile>
+					<general>
+						<id>2</id>
+						<name>FileVault</name>
+						<distribution_method>Install Automatically</distribution_method>
+						<user_removable>false</user_removable>
+						<level>computer</level>
+						<payloads>&lt;?xml version="1.0" encoding="UTF-8"?&gt;&lt;plist version="1"&gt;&lt;dict&gt;&lt;key&gt;PayloadDisplayName&lt;/key&gt;&lt;string&gt;FileVault&lt;/string&gt;&lt;key&gt;PayloadContent&lt;/key&gt;&lt;array/&gt;&lt;/dict&gt;&lt;/plist&gt;</payloads>
+					</general>
+					<scope>
+						<all_computers>true</all_computers>
+					</scope>
+				</os_x_configuration_profile>`)
+		case fmt.Sprintf("%s/id/-1", OSX_CONFIG_PROFILES_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			profile := &jamf.OSXConfigurationProfileContents{}
+			assert.Nil(t, xml.Unmarshal(data, profile))
+			assert.Equal(t, "Wi-Fi", profile.General.Name)
+			assert.True(t, profile.Scope.AllComputers)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><os_x_configuration_profile><id>3</id></os_x_configuration_profile>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf configuration profiles API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestOSXConfigurationProfiles(t *testing.T) {
+	testServer := osxConfigurationProfilesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	profiles, err := j.OSXConfigurationProfiles()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(profiles))
+	assert.Equal(t, 1, profiles[0].ID)
+	assert.Equal(t, "FileVault", profiles[1].Name)
+}
+
+func TestOSXConfigurationProfileDetails(t *testing.T) {
+	testServer := osxConfigurationProfilesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	profile, err := j.OSXConfigurationProfileDetails(1)
+	assert.Nil(t, err)
+	general := profile.Content.General
+	assert.Equal(t, "Screen Saver", general.Name)
+	assert.Equal(t, "Security", general.Category.Name)
+	assert.Equal(t, "Install Automatically", general.DistributionMethod)
+	assert.Equal(t, testMobileConfig, general.Payloads)
+	assert.Equal(t, 82, profile.Content.Scope.Computers[0].ID)
+	assert.Equal(t, "Install", profile.Content.SelfService.InstallBtnText)
+
+	config, err := general.MobileConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "Screen Saver", config.DisplayName)
+	assert.Equal(t, "Datadog", config.Organization)
+	assert.Equal(t, 1, config.Version)
+	assert.True(t, config.RemovalDisallowed)
+	assert.Equal(t, 1, len(config.Payloads))
+
+	payload := config.Payloads[0]
+	assert.Equal(t, "com.apple.screensaver", payload.Type)
+	assert.Equal(t, int64(600), payload.Settings["idleTime"])
+	assert.Equal(t, 5.5, payload.Settings["askForPasswordDelay"])
+	assert.Equal(t, false, payload.Settings["askForPassword"])
+	assert.Equal(t, []any{"Flurry"}, payload.Settings["modules"])
+	assert.Equal(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC), payload.Settings["expires"])
+	assert.Equal(t, []byte("hello"), payload.Settings["icon"])
+
+	// XML responses are decoded into the same structure
+	profile, err = j.OSXConfigurationProfileDetails("FileVault")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, profile.Content.General.ID)
+	assert.True(t, profile.Content.Scope.AllComputers)
+	config, err = profile.Content.General.MobileConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "FileVault", config.DisplayName)
+	assert.Empty(t, config.Payloads)
+}
+
+func TestCreateUpdateDeleteOSXConfigurationProfile(t *testing.T) {
+	testServer := osxConfigurationProfilesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	_, err = j.CreateOSXConfigurationProfile(&jamf.OSXConfigurationProfileContents{})
+	assert.NotNil(t, err)
+
+	created, err := j.CreateOSXConfigurationProfile(&jamf.OSXConfigurationProfileContents{
+		General: &jamf.OSXConfigurationProfileGeneral{Name: "Wi-Fi", Payloads: testMobileConfig},
+		Scope:   &jamf.Scope{AllComputers: true},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, created.ID)
+
+	updated, err := j.UpdateOSXConfigurationProfile(1, &jamf.OSXConfigurationProfileContents{
+		General: &jamf.OSXConfigurationProfileGeneral{Name: "Screen Saver (Updated)", Payloads: testMobileConfig},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, updated.ID)
+
+	deleted, err := j.DeleteOSXConfigurationProfile(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deleted.ID)
+}
+
+func TestParseMobileConfig__Invalid(t *testing.T) {
+	_, err := jamf.ParseMobileConfig("")
+	assert.NotNil(t, err)
+
+	_, err = jamf.ParseMobileConfig(`<plist version="1"><array><string>a</string></array></plist>`)
+	assert.NotNil(t, err)
+
+	_, err = jamf.ParseMobileConfig(`<plist version="1"><dict><key>a</key><integer>nope</integer></dict></plist>`)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,178 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Packages returns a list of packages available in Jamf
+func (j *Client) Packages() ([]BasicPackageInfo, error) {
+	return j.PackagesContext(context.Background())
+}
+
+// PackagesContext is like Packages but uses the given context for the request
+func (j *Client) PackagesContext(ctx context.Context) ([]BasicPackageInfo, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, packagesContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf packages query request")
+	}
+	res := PackageList{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available packages from %s", ep)
+	}
+	return res.List, nil
+}
+
+// PackageDetails returns the details for a specific package given its ID or Name
+func (j *Client) PackageDetails(identifier any) (*PackageRecord, error) {
+	return j.PackageDetailsContext(context.Background(), identifier)
+}
+
+// PackageDetailsContext is like PackageDetails but uses the given context for the request
+func (j *Client) PackageDetailsContext(ctx context.Context, identifier any) (*PackageRecord, error) {
+	ep, err := EndpointBuilder(j.Endpoint, packagesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for package: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for package: %v", identifier)
+	}
+
+	res := PackageRecord{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query package: %v from %s", identifier, ep)
+	}
+	return &res, nil
+}
+
+// UpdatePackage will update a package in Jamf by either ID or Name
+func (j *Client) UpdatePackage(identifier any, content *PackageContents) (*PackageContents, error) {
+	return j.UpdatePackageContext(context.Background(), identifier, content)
+}
+
+// UpdatePackageContext is like UpdatePackage but uses the given context for the request
+func (j *Client) UpdatePackageContext(ctx context.Context, identifier any, content *PackageContents) (*PackageContents, error) {
+	return j.updatePackage(ctx, identifier, content)
+}
+
+// packageHash is the update payload containing only the hash of a package so the rest of its settings
+// are left untouched
+type packageHash struct {
+	XMLName   xml.Name `xml:"package"`
+	HashType  string   `xml:"hash_type"`
+	HashValue string   `xml:"hash_value"`
+}
+
+// UpdatePackageHash sets the hash type and value of a package by either ID or Name, i.e once its file has been uploaded
+func (j *Client) UpdatePackageHash(identifier any, hashType string, hashValue string) (*PackageContents, error) {
+	return j.UpdatePackageHashContext(context.Background(), identifier, hashType, hashValue)
+}
+
+// UpdatePackageHashContext is like UpdatePackageHash but uses the given context for the request
+func (j *Client) UpdatePackageHashContext(ctx context.Context, identifier any, hashType string, hashValue string) (*PackageContents, error) {
+	if hashType == "" || hashValue == "" {
+		return nil, errors.Errorf("error building JAMF hash update for package: %v: hash type and value are required", identifier)
+	}
+	return j.updatePackage(ctx, identifier, &packageHash{HashType: hashType, HashValue: hashValue})
+}
+
+func (j *Client) updatePackage(ctx context.Context, identifier any, content any) (*PackageContents, error) {
+	ep, err := EndpointBuilder(j.Endpoint, packagesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for package: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for package: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for package: %v (%s)", identifier, ep)
+	}
+
+	res := PackageContents{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for package: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreatePackage will create a package in Jamf
+func (j *Client) CreatePackage(content *PackageContents) (*PackageContents, error) {
+	return j.CreatePackageContext(context.Background(), content)
+}
+
+// CreatePackageContext is like CreatePackage but uses the given context for the request
+func (j *Client) CreatePackageContext(ctx context.Context, content *PackageContents) (*PackageContents, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, packagesContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF query request for new package")
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new package"), "unable to process JAMF creation request for package: (%s)", ep)
+	}
+
+	if content.Filename == "" {
+		return nil, errors.Wrapf(fmt.Errorf("filename required for new package"), "unable to process JAMF creation request for package: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for package: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for package: %v (%s)", content.Name, ep)
+	}
+
+	res := PackageContents{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for package: %v (%s)", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// DeletePackage will delete a package by either ID or Name
+func (j *Client) DeletePackage(identifier any) (*PackageContents, error) {
+	return j.DeletePackageContext(context.Background(), identifier)
+}
+
+// DeletePackageContext is like DeletePackage but uses the given context for the request
+func (j *Client) DeletePackageContext(ctx context.Context, identifier any) (*PackageContents, error) {
+	ep, err := EndpointBuilder(j.Endpoint, packagesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for package: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for package: %v (%s)", identifier, ep)
+	}
+
+	res := PackageContents{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for package: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
@@ -3,7 +3,10 @@
 
 package classic
 
-import "encoding/xml"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // Packages holds a list of package details
 type Packages struct {
@@ -20,3 +23,59 @@ type Package struct {
 	FEU           bool     `json:"feu" xml:"feu,omitempty"`
 	UpdateAutorun bool     `json:"update_autorun" xml:"update_autorun,omitempty"`
 }
+
+// PackageList holds all packages available in the configured Jamf environment
+type PackageList struct {
+	List []BasicPackageInfo `json:"packages" xml:"package,omitempty"`
+	Size int                `json:"size" xml:"size"`
+}
+
+// BasicPackageInfo holds the basic information for all packages in Jamf
+type BasicPackageInfo struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name"`
+}
+
+// PackageRecord represents a single package record in Jamf. Unlike Package which references a
+// package from a policy, it holds the metadata of the package itself
+type PackageRecord struct {
+	Content PackageContents `json:"package" xml:"package,omitempty"`
+}
+
+// UnmarshalXML decodes the package element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (p *PackageRecord) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&p.Content, &start)
+}
+
+// PackageContents holds the metadata associated with a package in Jamf. TriggeringFiles is a legacy
+// setting which is kept as returned in JSON responses and is never sent back to Jamf
+type PackageContents struct {
+	XMLName                    xml.Name        `json:"-" xml:"package,omitempty"`
+	ID                         int             `json:"id,omitempty" xml:"id,omitempty"`
+	Name                       string          `json:"name" xml:"name,omitempty"`
+	Category                   string          `json:"category" xml:"category,omitempty"`
+	Filename                   string          `json:"filename" xml:"filename,omitempty"`
+	Info                       string          `json:"info" xml:"info,omitempty"`
+	Notes                      string          `json:"notes" xml:"notes,omitempty"`
+	Priority                   int             `json:"priority" xml:"priority,omitempty"`
+	RebootRequired             bool            `json:"reboot_required" xml:"reboot_required"`
+	FillUserTemplate           bool            `json:"fill_user_template" xml:"fill_user_template"`
+	FillExistingUsers          bool            `json:"fill_existing_users" xml:"fill_existing_users"`
+	BootVolumeRequired         bool            `json:"boot_volume_required" xml:"boot_volume_required"`
+	AllowUninstalled           bool            `json:"allow_uninstalled" xml:"allow_uninstalled"`
+	OSRequirements             string          `json:"os_requirements" xml:"os_requirements,omitempty"`
+	RequiredProcessor          string          `json:"required_processor" xml:"required_processor,omitempty"`
+	SwitchWithPackage          string          `json:"switch_with_package" xml:"switch_with_package,omitempty"`
+	InstallIfReportedAvailable bool            `json:"install_if_reported_available" xml:"install_if_reported_available"`
+	ReinstallOption            string          `json:"reinstall_option" xml:"reinstall_option,omitempty"`
+	TriggeringFiles            json.RawMessage `json:"triggering_files,omitempty" xml:"-"`
+	SendNotification           bool            `json:"send_notification" xml:"send_notification"`
+	HashType                   string          `json:"hash_type" xml:"hash_type,omitempty"`
+	HashValue                  string          `json:"hash_value" xml:"hash_value,omitempty"`
+}
+
+// PolicyPackage returns a reference to the package which can be added to a policy's package configuration
+func (p *PackageContents) PolicyPackage(action string) *Package {
+	return &Package{ID: p.ID, Name: p.Name, Action: action}
+}
@@ -0,0 +1,190 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var PACKAGES_BASE_API_ENDPOINT = "/JSSResource/packages"
+
+func packagesResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case PACKAGES_BASE_API_ENDPOINT:
+			fmt.Fprint(w, `{
+				"packages": [
+					{
+						"id": 12,
+						"name": "Zoom-Latest.pkg"
+					},
+					{
+						"id": 13,
+						"name": "datadog-agent-7.50.0-1.dmg"
+					}]
+				}`)
+		case fmt.Sprintf("%s/id/12", PACKAGES_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{
+					"package": {
+						"id": 12,
+						"name": "Zoom-Latest.pkg",
+						"category": "Productivity",
+						"filename": "Zoom-Latest.pkg",
+						"info": "Zoom client",
+						"notes": "Uploaded by autopkg",
+						"priority": 10,
+						"reboot_required": false,
+						"fill_user_template": true,
+						"fill_existing_users": false,
+						"boot_volume_required": true,
+						"allow_uninstalled": false,
+						"os_requirements": "14.x, 15.x",
+						"required_processor": "None",
+						"switch_with_package": "Do Not Install",
+						"install_if_reported_available": false,
+						"reinstall_option": "Do Not Reinstall",
+						"triggering_files": {},
+						"send_notification": false,
+						"hash_type": "SHA_512",
+						"hash_value": "cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e"
+					}
+				}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				pkg := &jamf.PackageContents{}
+				assert.Nil(t, xml.Unmarshal(data, pkg))
+				assert.Equal(t, "Security", pkg.Category)
+				assert.True(t, pkg.RebootRequired)
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><package><id>12</id></package>`)
+			case "DELETE":
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><package><id>12</id></package>`)
+			}
+		case fmt.Sprintf("%s/name/datadog-agent-7.50.0-1.dmg", PACKAGES_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<package>
+					<id>13</id>
+					<name>datadog-agent-7.50.0-1.dmg</name>
+					<category>Monitoring</category>
+					<filename>datadog-agent-7.50.0-1.dmg</filename>
+					<priority>5</priority>
+					<reboot_required>false</reboot_required>
+					<fill_user_template>false</fill_user_template>
+					<fill_existing_users>true</fill_existing_users>
+					<os_requirements>13.x</os_requirements>
+					<hash_type>MD5</hash_type>
+					<hash_value>d41d8cd98f00b204e9800998ecf8427e</hash_value>
+				</package>`)
+		case fmt.Sprintf("%s/id/14", PACKAGES_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, `<package><hash_type>SHA_512</hash_type><hash_value>cf83e135</hash_value></package>`, string(data))
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><package><id>14</id></package>`)
+		case fmt.Sprintf("%s/id/-1", PACKAGES_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			pkg := &jamf.PackageContents{}
+			assert.Nil(t, xml.Unmarshal(data, pkg))
+			assert.Equal(t, "Slack.pkg", pkg.Filename)
+			assert.Equal(t, 8, pkg.Priority)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><package><id>14</id></package>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf packages API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestPackages(t *testing.T) {
+	testServer := packagesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	packages, err := j.Packages()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(packages))
+	assert.Equal(t, 12, packages[0].ID)
+	assert.Equal(t, "datadog-agent-7.50.0-1.dmg", packages[1].Name)
+}
+
+func TestPackageDetails(t *testing.T) {
+	testServer := packagesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	pkg, err := j.PackageDetails(12)
+	assert.Nil(t, err)
+	assert.Equal(t, "Productivity", pkg.Content.Category)
+	assert.Equal(t, "Zoom-Latest.pkg", pkg.Content.Filename)
+	assert.Equal(t, 10, pkg.Content.Priority)
+	assert.True(t, pkg.Content.FillUserTemplate)
+	assert.True(t, pkg.Content.BootVolumeRequired)
+	assert.Equal(t, "14.x, 15.x", pkg.Content.OSRequirements)
+	assert.Equal(t, "SHA_512", pkg.Content.HashType)
+
+	ref := pkg.Content.PolicyPackage("Install")
+	assert.Equal(t, 12, ref.ID)
+	assert.Equal(t, "Zoom-Latest.pkg", ref.Name)
+	assert.Equal(t, "Install", ref.Action)
+
+	pkg, err = j.PackageDetails("datadog-agent-7.50.0-1.dmg")
+	assert.Nil(t, err)
+	assert.Equal(t, 13, pkg.Content.ID)
+	assert.Equal(t, "Monitoring", pkg.Content.Category)
+	assert.True(t, pkg.Content.FillExistingUsers)
+	assert.Equal(t, "d41d8cd98f00b204e9800998ecf8427e", pkg.Content.HashValue)
+}
+
+func TestCreateUpdateDeletePackage(t *testing.T) {
+	testServer := packagesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	_, err = j.CreatePackage(&jamf.PackageContents{Name: "Slack.pkg"})
+	assert.NotNil(t, err)
+
+	created, err := j.CreatePackage(&jamf.PackageContents{Name: "Slack.pkg", Filename: "Slack.pkg", Priority: 8})
+	assert.Nil(t, err)
+	assert.Equal(t, 14, created.ID)
+
+	updated, err := j.UpdatePackage(12, &jamf.PackageContents{Category: "Security", RebootRequired: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 12, updated.ID)
+
+	// packages can be fetched, edited and sent back as is
+	pkg, err := j.PackageDetails(12)
+	assert.Nil(t, err)
+	pkg.Content.Category = "Security"
+	pkg.Content.RebootRequired = true
+	updated, err = j.UpdatePackage(12, &pkg.Content)
+	assert.Nil(t, err)
+	assert.Equal(t, 12, updated.ID)
+
+	// only the hash is sent so the other settings of the package are left untouched
+	updated, err = j.UpdatePackageHash(14, "SHA_512", "cf83e135")
+	assert.Nil(t, err)
+	assert.Equal(t, 14, updated.ID)
+	_, err = j.UpdatePackageHash(14, "SHA_512", "")
+	assert.NotNil(t, err)
+
+	deleted, err := j.DeletePackage(12)
+	assert.Nil(t, err)
+	assert.Equal(t, 12, deleted.ID)
+}
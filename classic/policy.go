@@ -15,8 +15,13 @@ import (
 
 // Policies returns a list of policies available in the jamf client
 func (j *Client) Policies() ([]BasicPolicyInformation, error) {
+	return j.PoliciesContext(context.Background())
+}
+
+// PoliciesContext is like Policies but uses the given context for the request
+func (j *Client) PoliciesContext(ctx context.Context) ([]BasicPolicyInformation, error) {
 	ep := fmt.Sprintf("%s/%s", j.Endpoint, policiesContext)
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building Jamf policies query request")
 	}
@@ -24,17 +29,33 @@ func (j *Client) Policies() ([]BasicPolicyInformation, error) {
 	if err := j.makeAPIrequest(req, &res); err != nil {
 		return nil, errors.Wrapf(err, "unable to query available policies from %s", ep)
 	}
-	return res.List, nil
+	return filterBySite(ctx, j, res.List, func(ctx context.Context, item BasicPolicyInformation) error {
+		_, err := j.PolicyDetailsContext(ctx, item.ID)
+		return err
+	})
+}
+
+// policySite returns the site of a policy or nil when it isn't set
+func policySite(policy *PolicyContents) *Site {
+	if policy == nil || policy.General == nil || policy.General.Site == nil {
+		return nil
+	}
+	return &Site{ID: policy.General.Site.ID, Name: policy.General.Site.Name}
 }
 
 // PolicyDetails returns the details for a specific policy given its ID or Name
 func (j *Client) PolicyDetails(identifier interface{}) (*Policy, error) {
+	return j.PolicyDetailsContext(context.Background(), identifier)
+}
+
+// PolicyDetailsContext is like PolicyDetails but uses the given context for the request
+func (j *Client) PolicyDetailsContext(ctx context.Context, identifier interface{}) (*Policy, error) {
 	ep, err := EndpointBuilder(j.Endpoint, policiesContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for policy: %v", identifier)
 	}
 
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for policy: %v", identifier)
 	}
@@ -43,16 +64,36 @@ func (j *Client) PolicyDetails(identifier interface{}) (*Policy, error) {
 	if err := j.makeAPIrequest(req, &res); err != nil {
 		return nil, errors.Wrapf(err, "unable to query policy with ID: %d from %s", identifier, ep)
 	}
+	if err := j.checkSite(policySite(res.Content)); err != nil {
+		return nil, errors.Wrapf(err, "unable to query policy: %v from %s", identifier, ep)
+	}
 	return &res, nil
 }
 
 // UpdatePolicy will update a policy in Jamf by either ID or Name
 func (j *Client) UpdatePolicy(identifier interface{}, policy *PolicyContents) (*PolicyContents, error) {
+	return j.UpdatePolicyContext(context.Background(), identifier, policy)
+}
+
+// UpdatePolicyContext is like UpdatePolicy but uses the given context for the request
+func (j *Client) UpdatePolicyContext(ctx context.Context, identifier interface{}, policy *PolicyContents) (*PolicyContents, error) {
 	ep, err := EndpointBuilder(j.Endpoint, policiesContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for policy: %v", identifier)
 	}
 
+	if err := checkExistingSite(ctx, j, identifier, j.PolicyDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for policy: %v (%s)", identifier, ep)
+	}
+	// policies can't be moved out of the site the client is pinned to
+	if _, err := j.clientSite(policySite(policy)); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for policy: %v (%s)", identifier, ep)
+	}
+
+	if err := j.validateScope(ctx, policy.Scope); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for policy: %v (%s)", identifier, ep)
+	}
+
 	if len(policy.Scripts) > 0 {
 		policy.ScriptCount = len(policy.Scripts)
 		// Priority is required so we will default to After
@@ -69,7 +110,7 @@ func (j *Client) UpdatePolicy(identifier interface{}, policy *PolicyContents) (*
 	}
 
 	body := bytes.NewReader(bodyContent)
-	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF update request for policy: %v (%s)", identifier, ep)
 	}
@@ -84,6 +125,11 @@ func (j *Client) UpdatePolicy(identifier interface{}, policy *PolicyContents) (*
 
 // CreatePolicy will create a policy in Jamf
 func (j *Client) CreatePolicy(content *PolicyContents) (*PolicyContents, error) {
+	return j.CreatePolicyContext(context.Background(), content)
+}
+
+// CreatePolicyContext is like CreatePolicy but uses the given context for the request
+func (j *Client) CreatePolicyContext(ctx context.Context, content *PolicyContents) (*PolicyContents, error) {
 	// -1 denotes the next available ID
 	ep, err := EndpointBuilder(j.Endpoint, policiesContext, -1)
 	if err != nil {
@@ -94,6 +140,32 @@ func (j *Client) CreatePolicy(content *PolicyContents) (*PolicyContents, error)
 		return nil, errors.Wrapf(fmt.Errorf("name required for new policy"), "unable to process JAMF creation request for policy: (%s)", ep)
 	}
 
+	if j.site != nil {
+		site, err := j.clientSite(policySite(content))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to process JAMF creation request for policy: %v (%s)", content.General.Name, ep)
+		}
+		general := *content.General
+		general.Site = &PolicySite{ID: site.ID, Name: site.Name}
+		policy := *content
+		policy.General = &general
+		content = &policy
+	}
+
+	if c := content.General.Category; c != nil {
+		var category any = c.Name
+		if c.Name == "" {
+			category = c.ID
+		}
+		if err := j.validateCategory(ctx, category); err != nil {
+			return nil, errors.Wrapf(err, "unable to process JAMF creation request for policy: %v (%s)", content.General.Name, ep)
+		}
+	}
+
+	if err := j.validateScope(ctx, content.Scope); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for policy: %v (%s)", content.General.Name, ep)
+	}
+
 	if len(content.Scripts) > 0 {
 		content.ScriptCount = len(content.Scripts)
 		// Priority is required so we will default to After
@@ -110,7 +182,7 @@ func (j *Client) CreatePolicy(content *PolicyContents) (*PolicyContents, error)
 	}
 
 	body := bytes.NewReader(bodyContent)
-	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF creation request for policy: %v (%s)", content.General.Name, ep)
 	}
@@ -125,12 +197,21 @@ func (j *Client) CreatePolicy(content *PolicyContents) (*PolicyContents, error)
 
 // DeletePolicy will delete a policy by either ID or Name
 func (j *Client) DeletePolicy(identifier interface{}) (*PolicyGeneral, error) {
+	return j.DeletePolicyContext(context.Background(), identifier)
+}
+
+// DeletePolicyContext is like DeletePolicy but uses the given context for the request
+func (j *Client) DeletePolicyContext(ctx context.Context, identifier interface{}) (*PolicyGeneral, error) {
 	ep, err := EndpointBuilder(j.Endpoint, policiesContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for policy: %v", identifier)
 	}
 
-	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err := checkExistingSite(ctx, j, identifier, j.PolicyDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for policy: %v (%s)", identifier, ep)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF deletion request for policy: %v (%s)", identifier, ep)
 	}
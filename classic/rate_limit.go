@@ -0,0 +1,180 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RateLimit configures client side throttling of requests sent to Jamf
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the token bucket refills, 0 disables rate limiting
+	RequestsPerSecond float64
+	// Burst is the size of the token bucket i.e the number of requests that can be sent at once
+	Burst int
+	// MaxConcurrent is the maximum number of in-flight requests, 0 disables the cap
+	MaxConcurrent int
+}
+
+// RateLimitStats holds the wait time statistics of a rate limited client
+type RateLimitStats struct {
+	Requests  int64
+	Throttled int64
+	TotalWait time.Duration
+	MaxWait   time.Duration
+}
+
+func (l *RateLimit) validate() error {
+	if l.RequestsPerSecond < 0 || l.MaxConcurrent < 0 || l.Burst < 0 {
+		return errors.New("rate limit values must not be negative")
+	}
+	if l.RequestsPerSecond > 0 && l.Burst == 0 {
+		return errors.New("rate limit burst must be at least 1 when requests per second is set")
+	}
+	return nil
+}
+
+// limiter is a token bucket combined with a semaphore capping in-flight requests
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	sem    chan struct{}
+	stats  RateLimitStats
+}
+
+func newLimiter(cfg *RateLimit) *limiter {
+	l := &limiter{
+		rate:   cfg.RequestsPerSecond,
+		burst:  float64(cfg.Burst),
+		tokens: float64(cfg.Burst),
+		last:   time.Now(),
+	}
+	if cfg.MaxConcurrent > 0 {
+		l.sem = make(chan struct{}, cfg.MaxConcurrent)
+	}
+	return l
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it
+func (l *limiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back a token that was reserved but never used
+func (l *limiter) cancel() {
+	if l.rate <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+}
+
+// wait blocks until the request is allowed to be sent. The returned release function
+// must be called once the request is done to free its concurrency slot
+func (l *limiter) wait(ctx context.Context) (func(), error) {
+	start := time.Now()
+	if err := sleepContext(ctx, l.reserve()); err != nil {
+		l.cancel()
+		return nil, err
+	}
+
+	release := func() {}
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			l.cancel()
+			return nil, ctx.Err()
+		}
+		var once sync.Once
+		release = func() { once.Do(func() { <-l.sem }) }
+	}
+
+	l.record(time.Since(start))
+	return release, nil
+}
+
+func (l *limiter) record(waited time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.stats.Requests++
+	// waits under a millisecond are scheduling noise rather than throttling
+	if waited >= time.Millisecond {
+		l.stats.Throttled++
+		l.stats.TotalWait += waited
+		if waited > l.stats.MaxWait {
+			l.stats.MaxWait = waited
+		}
+	}
+}
+
+func (l *limiter) snapshot() RateLimitStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+// releaseOnClose frees the limiter slot once the response body has been consumed
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
+
+// RateLimitStats returns the wait time statistics for a client configured using WithRateLimit
+func (j *Client) RateLimitStats() RateLimitStats {
+	if j.limiter == nil {
+		return RateLimitStats{}
+	}
+	return j.limiter.snapshot()
+}
+
+// send waits for the client rate limit before sending a single request
+func (j *Client) send(r *http.Request) (*http.Response, error) {
+	if j.limiter == nil {
+		return j.doer.Do(r)
+	}
+
+	release, err := j.limiter.wait(r.Context())
+	if err != nil {
+		return nil, errors.Wrapf(err, "error waiting for rate limit to make %s request to %s", r.Method, r.URL)
+	}
+
+	res, err := j.doer.Do(r)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	res.Body = &releaseOnClose{ReadCloser: res.Body, release: release}
+	return res, nil
+}
@@ -0,0 +1,132 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+// concurrencyResponseMocks tracks the maximum number of requests being served at once
+func concurrencyResponseMocks(delay time.Duration) (*httptest.Server, *int32) {
+	var inFlight, maxInFlight int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(delay)
+		fmt.Fprint(w, `{"computer": {"general": {"id": 1, "name": "Rate Limited Mac"}}}`)
+	})), &maxInFlight
+}
+
+func TestRateLimitMaxConcurrent(t *testing.T) {
+	server, maxInFlight := concurrencyResponseMocks(20 * time.Millisecond)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRateLimit(&jamf.RateLimit{MaxConcurrent: 2}))
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			computer, err := j.ComputerDetails(1)
+			assert.Nil(t, err)
+			assert.Equal(t, "Rate Limited Mac", computer.Info.General.Name)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, atomic.LoadInt32(maxInFlight), int32(2))
+	stats := j.RateLimitStats()
+	assert.Equal(t, int64(8), stats.Requests)
+	assert.Greater(t, stats.Throttled, int64(0))
+	assert.Greater(t, stats.MaxWait, time.Duration(0))
+}
+
+func TestRateLimitTokenBucket(t *testing.T) {
+	server, _ := concurrencyResponseMocks(0)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRateLimit(&jamf.RateLimit{RequestsPerSecond: 50, Burst: 2}))
+	assert.Nil(t, err)
+
+	start := time.Now()
+	for i := 0; i < 7; i++ {
+		_, err := j.ComputerDetails(1)
+		assert.Nil(t, err)
+	}
+	// 2 requests are allowed by the burst and the remaining 5 are sent at 50 per second
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	stats := j.RateLimitStats()
+	assert.Equal(t, int64(7), stats.Requests)
+	assert.GreaterOrEqual(t, stats.Throttled, int64(4))
+	assert.GreaterOrEqual(t, stats.TotalWait, 80*time.Millisecond)
+}
+
+func TestRateLimitContextCanceled(t *testing.T) {
+	server, _ := concurrencyResponseMocks(0)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRateLimit(&jamf.RateLimit{RequestsPerSecond: 0.1, Burst: 1}))
+	assert.Nil(t, err)
+
+	_, err = j.ComputerDetails(1)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = j.ComputerDetailsContext(ctx, 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimitContextCanceledWaitingForSlot(t *testing.T) {
+	server, _ := concurrencyResponseMocks(100 * time.Millisecond)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRateLimit(&jamf.RateLimit{RequestsPerSecond: 1, Burst: 2, MaxConcurrent: 1}))
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := j.ComputerDetails(1)
+		assert.Nil(t, err)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// the request gives up while the only slot is taken so the token it reserved is returned
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = j.ComputerDetailsContext(ctx, 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	wg.Wait()
+
+	start := time.Now()
+	_, err = j.ComputerDetails(1)
+	assert.Nil(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestInvalidRateLimit(t *testing.T) {
+	_, err := jamf.NewClient("https://mock.test.com", "test", "test", nil, jamf.WithRateLimit(&jamf.RateLimit{RequestsPerSecond: 5}))
+	assert.NotNil(t, err)
+	assert.Equal(t, "rate limit burst must be at least 1 when requests per second is set", err.Error())
+
+	j, err := jamf.NewClient("https://mock.test.com", "test", "test", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.RateLimitStats{}, j.RateLimitStats())
+}
@@ -0,0 +1,169 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy configures how requests that fail with a transient error are retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request including the first one
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry, it doubles for every subsequent retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts including waits requested by the Retry-After header
+	MaxBackoff time.Duration
+	// RetryNonIdempotent allows POST requests to be retried as well
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the retry policy used by WithRetry
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+	}
+}
+
+func (p *RetryPolicy) validate() error {
+	if p.MaxAttempts < 1 {
+		return errors.New("retry policy max attempts must be at least 1")
+	}
+	if p.InitialBackoff < 0 || p.MaxBackoff < 0 {
+		return errors.New("retry policy backoff must not be negative")
+	}
+	return nil
+}
+
+// shouldRetry reports whether a request with the given method can be retried
+func (p *RetryPolicy) shouldRetry(method string) bool {
+	if p.RetryNonIdempotent {
+		return true
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns the exponential backoff with jitter for the given retry (starting at 1)
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < retry && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	// equal jitter keeps at least half of the backoff so retries never hit the server back to back
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
+
+// isRetryableStatus reports whether the status code is a transient Jamf failure
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses the Retry-After header which is either a number of seconds or an HTTP date
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	v := res.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// do sends the request applying the client retry policy and logs every attempt. The request
+// body is rewound between attempts so it must have been built with a body that supports GetBody
+func (j *Client) do(r *http.Request, idempotent bool) (*http.Response, error) {
+	p := j.retryPolicy
+	retry := p != nil && (idempotent || p.shouldRetry(r.Method)) && (r.Body == nil || r.GetBody != nil)
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to rewind request body for retry")
+			}
+			r.Body = body
+		}
+
+		start := time.Now()
+		res, err := j.send(r)
+		j.logRequest(r, res, err, attempt, time.Since(start))
+		if !retry || attempt >= p.MaxAttempts {
+			return res, err
+		}
+
+		var wait time.Duration
+		switch {
+		case err != nil:
+			// context errors are final, anything else (connection reset, timeout) is transient
+			if r.Context().Err() != nil {
+				return res, err
+			}
+			wait = p.backoff(attempt)
+		case isRetryableStatus(res.StatusCode):
+			if d, ok := retryAfter(res); ok {
+				// a server asking to come back much later must not block the caller for that long
+				wait = d
+				if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+					wait = p.MaxBackoff
+				}
+			} else {
+				wait = p.backoff(attempt)
+			}
+			res.Body.Close()
+		default:
+			return res, nil
+		}
+
+		j.logger.Warn("retrying Jamf request", "method", r.Method, "url", redactURL(r.URL), "attempt", attempt, "wait", wait)
+		if err := sleepContext(r.Context(), wait); err != nil {
+			return nil, errors.Wrapf(err, "error waiting to retry %s request to %s", r.Method, r.URL)
+		}
+	}
+}
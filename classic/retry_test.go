@@ -0,0 +1,196 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyResponseMocks fails the first `failures` requests with the given status code before responding successfully
+func flakyResponseMocks(t *testing.T, failures int32, status int, retryAfter string) (*httptest.Server, *int32) {
+	var calls int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		if n <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"scripts": [{"id": 1, "name": "Retry Script"}]}`)
+		default:
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, string(data))
+		}
+	})), &calls
+}
+
+func testRetryPolicy() *jamf.RetryPolicy {
+	return &jamf.RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+	}
+}
+
+func TestRetryTransientFailures(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server, calls := flakyResponseMocks(t, 3, status, "")
+			defer server.Close()
+			j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRetryPolicy(testRetryPolicy()))
+			assert.Nil(t, err)
+
+			scripts, err := j.Scripts()
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(scripts))
+			assert.Equal(t, "Retry Script", scripts[0].Name)
+			assert.Equal(t, int32(4), atomic.LoadInt32(calls))
+		})
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	server, calls := flakyResponseMocks(t, 10, http.StatusServiceUnavailable, "")
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRetryPolicy(testRetryPolicy()))
+	assert.Nil(t, err)
+
+	_, err = j.Scripts()
+	assert.NotNil(t, err)
+	var apiErr *jamf.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	assert.Equal(t, int32(4), atomic.LoadInt32(calls))
+}
+
+func TestRetryReplaysRequestBody(t *testing.T) {
+	server, calls := flakyResponseMocks(t, 2, http.StatusBadGateway, "")
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRetryPolicy(testRetryPolicy()))
+	assert.Nil(t, err)
+
+	updated, err := j.UpdateScript(1, &jamf.ScriptContents{Name: "Updated Retry Script"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Updated Retry Script", updated.Name)
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestRetrySkipsNonIdempotentRequests(t *testing.T) {
+	server, calls := flakyResponseMocks(t, 1, http.StatusServiceUnavailable, "")
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRetryPolicy(testRetryPolicy()))
+	assert.Nil(t, err)
+
+	_, err = j.CreateScript(&jamf.ScriptContents{Name: "New Script", Contents: "echo 'hello'"})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+
+	policy := testRetryPolicy()
+	policy.RetryNonIdempotent = true
+	server, calls = flakyResponseMocks(t, 1, http.StatusServiceUnavailable, "")
+	defer server.Close()
+	j, err = jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRetryPolicy(policy))
+	assert.Nil(t, err)
+
+	created, err := j.CreateScript(&jamf.ScriptContents{Name: "New Script", Contents: "echo 'hello'"})
+	assert.Nil(t, err)
+	assert.Equal(t, "New Script", created.Name)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	server, calls := flakyResponseMocks(t, 1, http.StatusTooManyRequests, "1")
+	defer server.Close()
+	policy := testRetryPolicy()
+	policy.MaxBackoff = 2 * time.Second
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRetryPolicy(policy))
+	assert.Nil(t, err)
+
+	start := time.Now()
+	_, err = j.Scripts()
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestRetryAfterCappedByMaxBackoff(t *testing.T) {
+	server, calls := flakyResponseMocks(t, 1, http.StatusTooManyRequests, "3600")
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRetryPolicy(testRetryPolicy()))
+	assert.Nil(t, err)
+
+	start := time.Now()
+	_, err = j.Scripts()
+	assert.Nil(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestRetryStopsWhenContextDone(t *testing.T) {
+	server, calls := flakyResponseMocks(t, 10, http.StatusServiceUnavailable, "30")
+	defer server.Close()
+	policy := testRetryPolicy()
+	policy.MaxBackoff = time.Minute
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithRetryPolicy(policy))
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = j.ScriptsContext(ctx)
+	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestRetryConnectionErrors(t *testing.T) {
+	server, calls := flakyResponseMocks(t, 0, http.StatusOK, "")
+	defer server.Close()
+
+	var failures int32
+	client := server.Client()
+	transport := client.Transport
+	client.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&failures, 1) <= 2 {
+			return nil, errors.New("connection reset by peer")
+		}
+		return transport.RoundTrip(r)
+	})
+
+	j, err := jamf.NewClient(server.URL, "test", "test", client, jamf.WithRetryPolicy(testRetryPolicy()))
+	assert.Nil(t, err)
+
+	scripts, err := j.Scripts()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(scripts))
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&failures))
+}
+
+func TestInvalidRetryPolicy(t *testing.T) {
+	_, err := jamf.NewClient("https://mock.test.com", "test", "test", nil, jamf.WithRetryPolicy(&jamf.RetryPolicy{MaxAttempts: 0}))
+	assert.NotNil(t, err)
+	assert.Equal(t, "retry policy max attempts must be at least 1", err.Error())
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
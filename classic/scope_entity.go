@@ -3,13 +3,15 @@
 
 package classic
 
+import "encoding/xml"
+
 // Scope represents the scope of a related Jamf configuration setting or Policy
 type Scope struct {
 	AllComputers   bool                      `json:"all_computers" xml:"all_computers,omitempty"`
 	Computers      []*BasicComputerInfo      `json:"computers" xml:"computers>computer,omitempty"`
 	ComputerGroups []*BasicComputerGroupInfo `json:"computer_groups" xml:"computer_groups>computer_group,omitempty"`
-	Buildings      []*Building               `json:"buildings" xml:"buildings,omitempty"`
-	Departments    []*Department             `json:"departments" xml:"departments,omitempty"`
+	Buildings      []*Building               `json:"buildings" xml:"buildings>building,omitempty"`
+	Departments    []*Department             `json:"departments" xml:"departments>department,omitempty"`
 	LimitToUsers   *UserGroupLimitations     `json:"limit_to_users" xml:"limit_to_users,omitempty"`
 	Limitations    *Limitations              `json:"limitations" xml:"limitations,omitempty"`
 	Exclusions     *Exclusions               `json:"exclusions" xml:"exclusions,omitempty"`
@@ -17,20 +19,20 @@ type Scope struct {
 
 // Building represents a building configured in Jamf that a setting can be scoped to
 type Building struct {
-	ID   int    `json:"id,omitempty"`
-	Name string `json:"name"`
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
 }
 
 // Department represents a department configured in Jamf that a setting can be scoped to
 type Department struct {
-	ID   int    `json:"id,omitempty"`
-	Name string `json:"name"`
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
 }
 
 // User represents a user configured in Jamf that a setting can be scoped to
 type User struct {
-	ID   int    `json:"id,omitempty"`
-	Name string `json:"name"`
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
 }
 
 // UserGroupLimitations represents the user groups to limit a scope to
@@ -44,20 +46,25 @@ type UserGroup struct {
 	Info *UserGroupDetails `json:"user_group"`
 }
 
-// UserGroupDetails holds the specific details of a user group
+// UserGroupDetails holds the specific details of a user group. Criteria only apply to smart
+// groups while static groups list their members in Users
 type UserGroupDetails struct {
-	ID             int    `json:"id,omitempty"`
-	Name           string `json:"name"`
-	IsSmart        bool   `json:"is_smart"`
-	NotifyOnChange bool   `json:"is_notify_on_change"`
+	XMLName        xml.Name          `json:"-" xml:"user_group,omitempty"`
+	ID             int               `json:"id,omitempty" xml:"id,omitempty"`
+	Name           string            `json:"name" xml:"name,omitempty"`
+	IsSmart        bool              `json:"is_smart" xml:"is_smart"`
+	NotifyOnChange bool              `json:"is_notify_on_change" xml:"is_notify_on_change"`
+	Site           *Site             `json:"site,omitempty" xml:"site,omitempty"`
+	Criteria       []Criterion       `json:"criteria,omitempty" xml:"criteria>criterion,omitempty"`
+	Users          []UserGroupMember `json:"users,omitempty" xml:"users>user,omitempty"`
 }
 
 // NetworkSegment represents a network segment configured in Jamf that a setting can be scoped to
 type NetworkSegment struct {
-	ID              int    `json:"id,omitempty"`
-	Name            string `json:"name"`
-	StartingAddress string `json:"starting_address"`
-	EndingAddress   string `json:"ending_address"`
+	ID              int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name            string `json:"name" xml:"name,omitempty"`
+	StartingAddress string `json:"starting_address" xml:"starting_address,omitempty"`
+	EndingAddress   string `json:"ending_address" xml:"ending_address,omitempty"`
 }
 
 // Limitations represents any limitations related to the specific scope
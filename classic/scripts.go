@@ -15,8 +15,13 @@ import (
 
 // Scripts returns a list of scripts available in the jamf client
 func (j *Client) Scripts() ([]BasicScriptInfo, error) {
+	return j.ScriptsContext(context.Background())
+}
+
+// ScriptsContext is like Scripts but uses the given context for the request
+func (j *Client) ScriptsContext(ctx context.Context) ([]BasicScriptInfo, error) {
 	ep := fmt.Sprintf("%s/%s", j.Endpoint, scriptsContext)
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building JAMF scripts query request")
 	}
@@ -24,17 +29,25 @@ func (j *Client) Scripts() ([]BasicScriptInfo, error) {
 	if err := j.makeAPIrequest(req, &res); err != nil {
 		return nil, errors.Wrapf(err, "unable to query available scripts from %s", ep)
 	}
-	return res.List, nil
+	return filterBySite(ctx, j, res.List, func(ctx context.Context, item BasicScriptInfo) error {
+		_, err := j.ScriptDetailsContext(ctx, item.ID)
+		return err
+	})
 }
 
 // ScriptDetails returns the details for a specific script given its ID or Name
 func (j *Client) ScriptDetails(identifier interface{}) (*Script, error) {
+	return j.ScriptDetailsContext(context.Background(), identifier)
+}
+
+// ScriptDetailsContext is like ScriptDetails but uses the given context for the request
+func (j *Client) ScriptDetailsContext(ctx context.Context, identifier interface{}) (*Script, error) {
 	ep, err := EndpointBuilder(j.Endpoint, scriptsContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for script: %v", identifier)
 	}
 
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for script: %v", identifier)
 	}
@@ -43,6 +56,13 @@ func (j *Client) ScriptDetails(identifier interface{}) (*Script, error) {
 	if err := j.makeAPIrequest(req, &res); err != nil {
 		return nil, errors.Wrapf(err, "unable to query script with ID: %d from %s", identifier, ep)
 	}
+	var site *Site
+	if res.Content != nil {
+		site = res.Content.Site
+	}
+	if err := j.checkSite(site); err != nil {
+		return nil, errors.Wrapf(err, "unable to query script: %v from %s", identifier, ep)
+	}
 
 	// default to map for script parameters
 	if res.Content.Parameters == nil {
@@ -54,11 +74,24 @@ func (j *Client) ScriptDetails(identifier interface{}) (*Script, error) {
 
 // UpdateScript will update a script in Jamf by either ID or Name
 func (j *Client) UpdateScript(identifier interface{}, script *ScriptContents) (*ScriptContents, error) {
+	return j.UpdateScriptContext(context.Background(), identifier, script)
+}
+
+// UpdateScriptContext is like UpdateScript but uses the given context for the request
+func (j *Client) UpdateScriptContext(ctx context.Context, identifier interface{}, script *ScriptContents) (*ScriptContents, error) {
 	ep, err := EndpointBuilder(j.Endpoint, scriptsContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for script: %v", identifier)
 	}
 
+	if err := checkExistingSite(ctx, j, identifier, j.ScriptDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for script: %v (%s)", identifier, ep)
+	}
+	// scripts can't be moved out of the site the client is pinned to
+	if _, err := j.clientSite(script.Site); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for script: %v (%s)", identifier, ep)
+	}
+
 	// TODO: Fix hack
 	// handle empty parameters since they can come in as
 	// map[string]interface{} which can not be handled by xml/encoding
@@ -73,7 +106,7 @@ func (j *Client) UpdateScript(identifier interface{}, script *ScriptContents) (*
 	}
 
 	body := bytes.NewReader(bodyContent)
-	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF update request for script: %v (%s)", identifier, ep)
 	}
@@ -88,6 +121,11 @@ func (j *Client) UpdateScript(identifier interface{}, script *ScriptContents) (*
 
 // CreateScript will create a script in Jamf
 func (j *Client) CreateScript(content *ScriptContents) (*ScriptContents, error) {
+	return j.CreateScriptContext(context.Background(), content)
+}
+
+// CreateScriptContext is like CreateScript but uses the given context for the request
+func (j *Client) CreateScriptContext(ctx context.Context, content *ScriptContents) (*ScriptContents, error) {
 	// -1 denotes the next available ID
 	ep, err := EndpointBuilder(j.Endpoint, scriptsContext, -1)
 	if err != nil {
@@ -102,9 +140,21 @@ func (j *Client) CreateScript(content *ScriptContents) (*ScriptContents, error)
 		return nil, errors.Wrapf(fmt.Errorf("script contents required"), "unable to process JAMF creation request for script: (%s)", ep)
 	}
 
+	site, err := j.clientSite(content.Site)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for script: %v (%s)", content.Name, ep)
+	}
+
+	if err := j.validateCategory(ctx, content.Category); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for script: %v (%s)", content.Name, ep)
+	}
+
 	if content.Filename == "" {
 		content.Filename = content.Name
 	}
+	payload := *content
+	payload.Site = site
+	content = &payload
 
 	bodyContent, err := xml.Marshal(content)
 	if err != nil {
@@ -112,7 +162,7 @@ func (j *Client) CreateScript(content *ScriptContents) (*ScriptContents, error)
 	}
 
 	body := bytes.NewReader(bodyContent)
-	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF creation request for script: %v (%s)", content.Name, ep)
 	}
@@ -127,12 +177,21 @@ func (j *Client) CreateScript(content *ScriptContents) (*ScriptContents, error)
 
 // DeleteScript will delete a script by either ID or Name
 func (j *Client) DeleteScript(identifier interface{}) (*ScriptContents, error) {
+	return j.DeleteScriptContext(context.Background(), identifier)
+}
+
+// DeleteScriptContext is like DeleteScript but uses the given context for the request
+func (j *Client) DeleteScriptContext(ctx context.Context, identifier interface{}) (*ScriptContents, error) {
 	ep, err := EndpointBuilder(j.Endpoint, scriptsContext, identifier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF query request for script: %v", identifier)
 	}
 
-	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err := checkExistingSite(ctx, j, identifier, j.ScriptDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for script: %v (%s)", identifier, ep)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error building JAMF deletion request for script: %v (%s)", identifier, ep)
 	}
@@ -35,6 +35,7 @@ type ScriptContents struct {
 	Requirements    string      `json:"os_requirements" xml:"os_requirements,omitempty"`
 	Contents        string      `json:"script_contents" xml:"script_contents,omitempty"`
 	EncodedContents string      `json:"script_contents_encoded" xml:"script_contents_encoded,omitempty"`
+	Site            *Site       `json:"site,omitempty" xml:"site,omitempty"`
 }
 
 // ParametersList holds the potential parameters that can be specified for a script in Jamf
@@ -0,0 +1,273 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Sites returns a list of sites available in Jamf
+func (j *Client) Sites() ([]Site, error) {
+	return j.SitesContext(context.Background())
+}
+
+// SitesContext is like Sites but uses the given context for the request
+func (j *Client) SitesContext(ctx context.Context) ([]Site, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, sitesContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf sites query request")
+	}
+	res := Sites{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available sites from %s", ep)
+	}
+	return res.List, nil
+}
+
+// SiteDetails returns the details for a specific site given its ID or Name
+func (j *Client) SiteDetails(identifier any) (*Site, error) {
+	return j.SiteDetailsContext(context.Background(), identifier)
+}
+
+// SiteDetailsContext is like SiteDetails but uses the given context for the request
+func (j *Client) SiteDetailsContext(ctx context.Context, identifier any) (*Site, error) {
+	ep, err := EndpointBuilder(j.Endpoint, sitesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for site: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for site: %v", identifier)
+	}
+
+	res := siteResponse{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query site: %v from %s", identifier, ep)
+	}
+	return &res.Details, nil
+}
+
+// UpdateSite will update a site in Jamf by either ID or Name
+func (j *Client) UpdateSite(identifier any, site *Site) (*Site, error) {
+	return j.UpdateSiteContext(context.Background(), identifier, site)
+}
+
+// UpdateSiteContext is like UpdateSite but uses the given context for the request
+func (j *Client) UpdateSiteContext(ctx context.Context, identifier any, site *Site) (*Site, error) {
+	ep, err := EndpointBuilder(j.Endpoint, sitesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for site: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(site)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for site: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for site: %v (%s)", identifier, ep)
+	}
+
+	res := Site{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for site: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateSite will create a site in Jamf
+func (j *Client) CreateSite(site *Site) (*Site, error) {
+	return j.CreateSiteContext(context.Background(), site)
+}
+
+// CreateSiteContext is like CreateSite but uses the given context for the request
+func (j *Client) CreateSiteContext(ctx context.Context, site *Site) (*Site, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, sitesContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF query request for new site")
+	}
+
+	if site.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new site"), "unable to process JAMF creation request for site: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(site)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for site: %v", site.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for site: %v (%s)", site.Name, ep)
+	}
+
+	res := Site{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for site: %v (%s)", site.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteSite will delete a site by either ID or Name
+func (j *Client) DeleteSite(identifier any) (*Site, error) {
+	return j.DeleteSiteContext(context.Background(), identifier)
+}
+
+// DeleteSiteContext is like DeleteSite but uses the given context for the request
+func (j *Client) DeleteSiteContext(ctx context.Context, identifier any) (*Site, error) {
+	ep, err := EndpointBuilder(j.Endpoint, sitesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for site: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for site: %v (%s)", identifier, ep)
+	}
+
+	res := Site{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for site: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// isNoSite reports whether a site is unset, Jamf uses an ID of -1 named None for objects
+// which don't belong to a site
+func isNoSite(site *Site) bool {
+	return site == nil || (site.ID <= 0 && (site.Name == "" || site.Name == "None"))
+}
+
+// inClientSite reports whether the given site is the one the client is pinned to using WithSite
+func (j *Client) inClientSite(site *Site) bool {
+	if j.site == nil {
+		return true
+	}
+	if isNoSite(site) {
+		return false
+	}
+	if j.site.ID > 0 && site.ID > 0 {
+		return j.site.ID == site.ID
+	}
+	return j.site.Name == site.Name
+}
+
+// clientSite returns the site a new object should be created in. When the client is pinned to a site
+// it is used as the default and any other site is rejected with a *SiteMismatchError
+func (j *Client) clientSite(site *Site) (*Site, error) {
+	if j.site == nil {
+		return site, nil
+	}
+	if isNoSite(site) {
+		s := *j.site
+		return &s, nil
+	}
+	if !j.inClientSite(site) {
+		return nil, &SiteMismatchError{Site: *site, ClientSite: *j.site}
+	}
+	return site, nil
+}
+
+// checkSite returns a *SiteMismatchError when the client is pinned to a site using WithSite and the
+// given site of an existing object is a different one
+func (j *Client) checkSite(site *Site) error {
+	if j.inClientSite(site) {
+		return nil
+	}
+	mismatch := &SiteMismatchError{ClientSite: *j.site}
+	if site != nil {
+		mismatch.Site = *site
+	}
+	return mismatch
+}
+
+// checkExistingSite looks up the details of an existing object before it is updated or deleted so a
+// client pinned to a site using WithSite never modifies objects in other sites. Clients which aren't
+// pinned to a site can modify any object so no lookup is made for them
+func checkExistingSite[T any](ctx context.Context, j *Client, identifier any, details func(context.Context, any) (T, error)) error {
+	if j.site == nil {
+		// skipping the lookup keeps updates and deletes to a single request
+		return nil
+	}
+	_, err := details(ctx, identifier)
+	return err
+}
+
+// siteLookupConcurrency bounds the number of detail requests filterBySite makes at the same time
+const siteLookupConcurrency = 8
+
+// filterBySite keeps the items in the site the client is pinned to. The classic API doesn't return
+// sites when listing objects so the details of each item are looked up, up to siteLookupConcurrency at
+// a time, which fail with a *SiteMismatchError for items in other sites. Items deleted since the list
+// was fetched are skipped
+func filterBySite[T any](ctx context.Context, j *Client, items []T, details func(context.Context, T) error) ([]T, error) {
+	if j.site == nil {
+		return items, nil
+	}
+
+	// outstanding lookups are cancelled as soon as one of them fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		failOnce sync.Once
+		failed   error
+	)
+	kept := make([]bool, len(items))
+	sem := make(chan struct{}, siteLookupConcurrency)
+	for i, item := range items {
+		sem <- struct{}{}
+		if ctx.Err() != nil {
+			<-sem
+			break
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			err := details(ctx, item)
+			switch {
+			case err == nil:
+				kept[i] = true
+			case errors.Is(err, ErrSiteMismatch), errors.Is(err, ErrNotFound):
+			default:
+				failOnce.Do(func() {
+					failed = err
+					cancel()
+				})
+			}
+		})
+	}
+	wg.Wait()
+
+	if failed != nil {
+		return nil, errors.Wrap(failed, "unable to look up site")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "unable to look up site")
+	}
+
+	filtered := make([]T, 0, len(items))
+	for i, item := range items {
+		if kept[i] {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
@@ -6,7 +6,7 @@ import "encoding/xml"
 
 // Sites holds a list of sites configured in Jamf
 type Sites struct {
-	List  []Site `json:"sites" xml:"sites>site,omitempty"`
+	List  []Site `json:"sites" xml:"site,omitempty"`
 	Count int    `json:"-" xml:"size"`
 }
 
@@ -16,3 +16,14 @@ type Site struct {
 	ID      int      `json:"id,omitempty" xml:"id,omitempty"`
 	Name    string   `json:"name" xml:"name,omitempty"`
 }
+
+// siteResponse unwraps the site returned by the classic API
+type siteResponse struct {
+	Details Site `json:"site"`
+}
+
+// UnmarshalXML decodes the site element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (s *siteResponse) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&s.Details, &start)
+}
@@ -0,0 +1,390 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var SITES_BASE_API_ENDPOINT = "/JSSResource/sites"
+
+func sitesResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case SITES_BASE_API_ENDPOINT:
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<sites>
+					<size>2</size>
+					<site><id>1</id><name>EMEA</name></site>
+					<site><id>2</id><name>APAC</name></site>
+				</sites>`)
+		case fmt.Sprintf("%s/id/1", SITES_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{"site": {"id": 1, "name": "EMEA"}}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				assert.Equal(t, `<site><name>Europe</name></site>`, string(data))
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><site><id>1</id></site>`)
+			case "DELETE":
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><site><id>1</id></site>`)
+			}
+		case fmt.Sprintf("%s/name/APAC", SITES_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><site><id>2</id><name>APAC</name></site>`)
+		case fmt.Sprintf("%s/id/-1", SITES_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, `<site><name>AMER</name></site>`, string(data))
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><site><id>3</id></site>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf sites API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestSites(t *testing.T) {
+	testServer := sitesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	sites, err := j.Sites()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(sites))
+	assert.Equal(t, "APAC", sites[1].Name)
+
+	site, err := j.SiteDetails(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "EMEA", site.Name)
+
+	site, err = j.SiteDetails("APAC")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, site.ID)
+}
+
+func TestCreateUpdateDeleteSite(t *testing.T) {
+	testServer := sitesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	_, err = j.CreateSite(&jamf.Site{})
+	assert.NotNil(t, err)
+
+	site, err := j.CreateSite(&jamf.Site{Name: "AMER"})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, site.ID)
+
+	site, err = j.UpdateSite(1, &jamf.Site{Name: "Europe"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, site.ID)
+
+	site, err = j.DeleteSite(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, site.ID)
+}
+
+// siteScopedResponseMocks serves policies, scripts and groups spread across the EMEA and APAC sites
+func siteScopedResponseMocks(t *testing.T, created map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case "/JSSResource/policies":
+			fmt.Fprint(w, `{"policies": [{"id": 1, "name": "EMEA Policy"}, {"id": 2, "name": "APAC Policy"}, {"id": 3, "name": "Global Policy"}, {"id": 4, "name": "Deleted Policy"}]}`)
+		case "/JSSResource/scripts":
+			fmt.Fprint(w, `{"scripts": [{"id": 1, "name": "EMEA Script"}, {"id": 2, "name": "APAC Script"}]}`)
+		case "/JSSResource/policies/id/1", "/JSSResource/policies/id/2", "/JSSResource/computergroups/id/1", "/JSSResource/computergroups/id/2", "/JSSResource/scripts/id/1", "/JSSResource/scripts/id/2":
+			if r.Method != "GET" {
+				created[r.Method+" "+r.URL.Path] = ""
+				w.Header().Add("Content-Type", "application/xml")
+				root := "policy"
+				if strings.HasPrefix(r.URL.Path, "/JSSResource/computergroups") {
+					root = "computer_group"
+				} else if strings.HasPrefix(r.URL.Path, "/JSSResource/scripts") {
+					root = "script"
+				}
+				fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><%s><id>1</id></%s>`, root, root)
+				return
+			}
+			fmt.Fprint(w, map[string]string{
+				"/JSSResource/policies/id/1":       `{"policy": {"general": {"id": 1, "name": "EMEA Policy", "site": {"id": 1, "name": "EMEA"}}}}`,
+				"/JSSResource/policies/id/2":       `{"policy": {"general": {"id": 2, "name": "APAC Policy", "site": {"id": 2, "name": "APAC"}}}}`,
+				"/JSSResource/computergroups/id/1": `{"computer_group": {"id": 1, "name": "EMEA Laptops", "is_smart": true, "site": {"id": 1, "name": "EMEA"}}}`,
+				"/JSSResource/computergroups/id/2": `{"computer_group": {"id": 2, "name": "APAC Laptops", "is_smart": true, "site": {"id": 2, "name": "APAC"}}}`,
+				"/JSSResource/scripts/id/1":        `{"script": {"id": 1, "name": "EMEA Script", "site": {"id": 1, "name": "EMEA"}}}`,
+				"/JSSResource/scripts/id/2":        `{"script": {"id": 2, "name": "APAC Script", "site": {"id": 2, "name": "APAC"}}}`,
+			}[r.URL.Path])
+		case "/JSSResource/policies/id/4":
+			http.Error(w, "Not Found", http.StatusNotFound)
+		case "/JSSResource/policies/id/3":
+			fmt.Fprint(w, `{"policy": {"general": {"id": 3, "name": "Global Policy", "site": {"id": -1, "name": "None"}}}}`)
+		case "/JSSResource/computergroups":
+			fmt.Fprint(w, `{"computer_groups": [{"id": 1, "name": "EMEA Laptops", "is_smart": true}, {"id": 2, "name": "APAC Laptops", "is_smart": true}]}`)
+		case "/JSSResource/policies/id/-1", "/JSSResource/scripts/id/-1", "/JSSResource/computergroups/id/-1", "/JSSResource/mobiledevicegroups/id/-1", "/JSSResource/usergroups/id/-1":
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			created[r.URL.Path] = string(data)
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			root := map[string]string{
+				"/JSSResource/policies/id/-1":           "policy",
+				"/JSSResource/scripts/id/-1":            "script",
+				"/JSSResource/computergroups/id/-1":     "computer_group",
+				"/JSSResource/mobiledevicegroups/id/-1": "mobile_device_group",
+				"/JSSResource/usergroups/id/-1":         "user_group",
+			}[r.URL.Path]
+			fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><%s><id>10</id></%s>`, root, root)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestWithSite(t *testing.T) {
+	created := map[string]string{}
+	testServer := siteScopedResponseMocks(t, created)
+	defer testServer.Close()
+
+	_, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithSite(jamf.Site{}))
+	assert.NotNil(t, err)
+
+	// unpinned clients list everything
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	policies, err := j.Policies()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(policies))
+
+	j, err = jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithSite(jamf.Site{ID: 1, Name: "EMEA"}))
+	assert.Nil(t, err)
+
+	// policies deleted while listing are skipped rather than failing the whole list
+	policies, err = j.Policies()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(policies))
+	assert.Equal(t, "EMEA Policy", policies[0].Name)
+
+	scripts, err := j.Scripts()
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.BasicScriptInfo{{ID: 1, Name: "EMEA Script"}}, scripts)
+
+	groups, err := j.ComputerGroups()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, 1, groups[0].ID)
+
+	// sites can also be pinned by name alone
+	j, err = jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithSite(jamf.Site{Name: "APAC"}))
+	assert.Nil(t, err)
+	groups, err = j.ComputerGroups()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, "APAC Laptops", groups[0].Name)
+}
+
+func TestWithSiteCreate(t *testing.T) {
+	created := map[string]string{}
+	testServer := siteScopedResponseMocks(t, created)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithSite(jamf.Site{ID: 1, Name: "EMEA"}))
+	assert.Nil(t, err)
+
+	// the site is set on the payload sent to Jamf rather than on the caller's struct
+	newPolicy := &jamf.PolicyContents{General: &jamf.PolicyGeneral{Name: "Install Zoom"}}
+	_, err = j.CreatePolicy(newPolicy)
+	assert.Nil(t, err)
+	assert.Nil(t, newPolicy.General.Site)
+	policy := &jamf.PolicyContents{}
+	assert.Nil(t, xml.Unmarshal([]byte(created["/JSSResource/policies/id/-1"]), policy))
+	assert.Equal(t, 1, policy.General.Site.ID)
+	assert.Equal(t, "EMEA", policy.General.Site.Name)
+
+	newScript := &jamf.ScriptContents{Name: "Install Zoom", Contents: "#!/bin/bash"}
+	_, err = j.CreateScript(newScript)
+	assert.Nil(t, err)
+	assert.Nil(t, newScript.Site)
+	script := &jamf.ScriptContents{}
+	assert.Nil(t, xml.Unmarshal([]byte(created["/JSSResource/scripts/id/-1"]), script))
+	assert.Equal(t, 1, script.Site.ID)
+
+	_, err = j.CreateComputerGroup(&jamf.ComputerGroupDetails{BasicComputerGroupInfo: jamf.BasicComputerGroupInfo{Name: "EMEA Desktops"}})
+	assert.Nil(t, err)
+	computerGroup := &jamf.ComputerGroupDetails{}
+	assert.Nil(t, xml.Unmarshal([]byte(created["/JSSResource/computergroups/id/-1"]), computerGroup))
+	assert.Equal(t, 1, computerGroup.Site.ID)
+
+	newMobileDeviceGroup := &jamf.MobileDeviceGroupDetails{
+		BasicMobileDeviceGroupInfo: jamf.BasicMobileDeviceGroupInfo{Name: "EMEA iPads"},
+		Site:                       &jamf.Site{ID: -1, Name: "None"},
+	}
+	_, err = j.CreateMobileDeviceGroup(newMobileDeviceGroup)
+	assert.Nil(t, err)
+	assert.Equal(t, "None", newMobileDeviceGroup.Site.Name)
+	mobileDeviceGroup := &jamf.MobileDeviceGroupDetails{}
+	assert.Nil(t, xml.Unmarshal([]byte(created["/JSSResource/mobiledevicegroups/id/-1"]), mobileDeviceGroup))
+	assert.Equal(t, "EMEA", mobileDeviceGroup.Site.Name)
+
+	_, err = j.CreateUserGroup(&jamf.UserGroupDetails{Name: "EMEA Staff", Site: &jamf.Site{Name: "EMEA"}})
+	assert.Nil(t, err)
+
+	// creating objects in another site is rejected before any request is made
+	_, err = j.CreateUserGroup(&jamf.UserGroupDetails{Name: "APAC Staff", Site: &jamf.Site{ID: 2, Name: "APAC"}})
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	var mismatch *jamf.SiteMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, "APAC", mismatch.Site.Name)
+	assert.Equal(t, "EMEA", mismatch.ClientSite.Name)
+
+	_, err = j.CreatePolicy(&jamf.PolicyContents{General: &jamf.PolicyGeneral{Name: "Install Slack", Site: &jamf.PolicySite{ID: 2}}})
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	_, err = j.CreateScript(&jamf.ScriptContents{Name: "Install Slack", Contents: "#!/bin/bash", Site: &jamf.Site{ID: 2, Name: "APAC"}})
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	assert.Equal(t, 5, len(created))
+}
+
+func TestWithSiteDetailsUpdateDelete(t *testing.T) {
+	modified := map[string]string{}
+	testServer := siteScopedResponseMocks(t, modified)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithSite(jamf.Site{ID: 1, Name: "EMEA"}))
+	assert.Nil(t, err)
+
+	policy, err := j.PolicyDetails(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "EMEA Policy", policy.Content.General.Name)
+
+	// objects in other sites can't be read, updated or deleted
+	_, err = j.PolicyDetails(2)
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	_, err = j.UpdatePolicy(2, &jamf.PolicyContents{General: &jamf.PolicyGeneral{Name: "APAC Policy"}})
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	_, err = j.DeletePolicy(2)
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	_, err = j.ComputerGroupDetails(2)
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	_, err = j.DeleteComputerGroup(2)
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	_, err = j.ScriptDetails(2)
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	_, err = j.UpdateScript(2, &jamf.ScriptContents{Name: "APAC Script"})
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	_, err = j.DeleteScript(2)
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+
+	// nor can objects be moved to another site
+	_, err = j.UpdatePolicy(1, &jamf.PolicyContents{General: &jamf.PolicyGeneral{Name: "EMEA Policy", Site: &jamf.PolicySite{ID: 2, Name: "APAC"}}})
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	_, err = j.UpdateScript(1, &jamf.ScriptContents{Name: "EMEA Script", Site: &jamf.Site{ID: 2, Name: "APAC"}})
+	assert.True(t, errors.Is(err, jamf.ErrSiteMismatch))
+	assert.Empty(t, modified)
+
+	_, err = j.UpdatePolicy(1, &jamf.PolicyContents{General: &jamf.PolicyGeneral{Name: "EMEA Policy"}})
+	assert.Nil(t, err)
+	_, err = j.DeleteComputerGroup(1)
+	assert.Nil(t, err)
+	_, err = j.DeleteScript(1)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"PUT /JSSResource/policies/id/1":          "",
+		"DELETE /JSSResource/computergroups/id/1": "",
+		"DELETE /JSSResource/scripts/id/1":        "",
+	}, modified)
+}
+
+func TestWithoutSiteUpdateDelete(t *testing.T) {
+	modified := map[string]string{}
+	testServer := siteScopedResponseMocks(t, modified)
+	defer testServer.Close()
+	var lookups int32
+	counting := func(next jamf.Doer) jamf.Doer {
+		return jamf.DoerFunc(func(r *http.Request) (*http.Response, error) {
+			if r.Method == "GET" {
+				atomic.AddInt32(&lookups, 1)
+			}
+			return next.Do(r)
+		})
+	}
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithMiddleware(counting))
+	assert.Nil(t, err)
+
+	// unpinned clients don't look up the site of an object before modifying it
+	_, err = j.UpdatePolicy(2, &jamf.PolicyContents{General: &jamf.PolicyGeneral{Name: "APAC Policy"}})
+	assert.Nil(t, err)
+	_, err = j.DeleteComputerGroup(2)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"PUT /JSSResource/policies/id/2": "", "DELETE /JSSResource/computergroups/id/2": ""}, modified)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&lookups))
+}
+
+func TestWithSiteConcurrentLookups(t *testing.T) {
+	var inFlight, maxInFlight, failID int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.RequestURI == "/JSSResource/computergroups" {
+			groups := []string{}
+			for id := 1; id <= 20; id++ {
+				groups = append(groups, fmt.Sprintf(`{"id": %d, "name": "Group %d"}`, id, id))
+			}
+			fmt.Fprintf(w, `{"computer_groups": [%s]}`, strings.Join(groups, ","))
+			return
+		}
+
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		var id int
+		_, err := fmt.Sscanf(r.URL.Path, "/JSSResource/computergroups/id/%d", &id)
+		assert.Nil(t, err)
+		if int32(id) == atomic.LoadInt32(&failID) {
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		site := `{"id": 1, "name": "EMEA"}`
+		if id%2 == 0 {
+			site = `{"id": 2, "name": "APAC"}`
+		}
+		fmt.Fprintf(w, `{"computer_group": {"id": %d, "name": "Group %d", "site": %s}}`, id, id, site)
+	}))
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, jamf.WithSite(jamf.Site{ID: 1, Name: "EMEA"}))
+	assert.Nil(t, err)
+
+	// lookups run concurrently but never more than a handful at a time, and the list keeps its order
+	groups, err := j.ComputerGroups()
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(groups))
+	for i, group := range groups {
+		assert.Equal(t, i*2+1, group.ID)
+	}
+	assert.True(t, atomic.LoadInt32(&maxInFlight) > 1)
+	assert.True(t, atomic.LoadInt32(&maxInFlight) <= 8)
+
+	// any other failure fails the whole list
+	atomic.StoreInt32(&failID, 13)
+	_, err = j.ComputerGroups()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,159 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+const instrumentationName = "github.com/DataDog/jamf-api-client-go/classic"
+
+// Attribute keys recorded on spans and metrics
+const (
+	attrResource       = attribute.Key("jamf.resource")
+	attrIdentifierType = attribute.Key("jamf.identifier_type")
+	attrMethod         = attribute.Key("http.request.method")
+	attrStatusCode     = attribute.Key("http.response.status_code")
+	attrAuthOutcome    = attribute.Key("jamf.auth.outcome")
+)
+
+// identifierTypes are the path segments used to look up a classic API resource
+var identifierTypes = map[string]bool{
+	"id":           true,
+	"name":         true,
+	"serialnumber": true,
+	"udid":         true,
+	"macaddress":   true,
+}
+
+// apiVersion matches the version segment of a Jamf Pro API path
+var apiVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// instrumentation holds the tracer and metric instruments used by the client. When
+// instrumentation is not enabled the noop implementations are used
+type instrumentation struct {
+	tracer        trace.Tracer
+	requests      metric.Int64Counter
+	duration      metric.Float64Histogram
+	authRefreshes metric.Int64Counter
+}
+
+func newInstrumentation(tp trace.TracerProvider, mp metric.MeterProvider) (*instrumentation, error) {
+	if tp == nil {
+		tp = tracenoop.NewTracerProvider()
+	}
+	if mp == nil {
+		mp = metricnoop.NewMeterProvider()
+	}
+
+	meter := mp.Meter(instrumentationName)
+	requests, err := meter.Int64Counter("jamf.client.requests",
+		metric.WithDescription("Number of requests made to the Jamf API"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := meter.Float64Histogram("jamf.client.request.duration",
+		metric.WithDescription("Duration of requests made to the Jamf API"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	authRefreshes, err := meter.Int64Counter("jamf.client.auth.refreshes",
+		metric.WithDescription("Number of auth token refreshes"),
+		metric.WithUnit("{refresh}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &instrumentation{
+		tracer:        tp.Tracer(instrumentationName),
+		requests:      requests,
+		duration:      duration,
+		authRefreshes: authRefreshes,
+	}, nil
+}
+
+// resourceAttributes derives the resource context and identifier type from a request URL
+// i.e /JSSResource/computers/serialnumber/C02XXXX => computers, serialnumber
+// and /api/v1/computers-inventory/1 => computers-inventory
+func resourceAttributes(u *url.URL) []attribute.KeyValue {
+	path := strings.Trim(u.Path, "/")
+	if i := strings.Index(path, "JSSResource/"); i >= 0 {
+		path = path[i+len("JSSResource/"):]
+	} else if i := strings.Index(path, "api/"); i >= 0 {
+		path = path[i+len("api/"):]
+		// Jamf Pro API paths are versioned i.e v1, v2
+		if version, rest, ok := strings.Cut(path, "/"); ok && apiVersion.MatchString(version) {
+			path = rest
+		}
+	}
+
+	segments := strings.Split(path, "/")
+	attrs := []attribute.KeyValue{attrResource.String(segments[0])}
+	for _, s := range segments[1:] {
+		if identifierTypes[s] {
+			attrs = append(attrs, attrIdentifierType.String(s))
+			break
+		}
+	}
+	return attrs
+}
+
+// startRequest starts the span for a request made through makeAPIrequest
+func (in *instrumentation) startRequest(r *http.Request) (context.Context, trace.Span, []attribute.KeyValue) {
+	attrs := append(resourceAttributes(r.URL), attrMethod.String(r.Method))
+	name := "jamf." + strings.ToLower(r.Method)
+	for _, a := range attrs {
+		if a.Key == attrResource {
+			name += " " + a.Value.AsString()
+		}
+	}
+	ctx, span := in.tracer.Start(r.Context(), name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	return ctx, span, attrs
+}
+
+// endRequest ends the span and records the request metrics
+func (in *instrumentation) endRequest(ctx context.Context, span trace.Span, attrs []attribute.KeyValue, status int, start time.Time, err error) {
+	if status > 0 {
+		attrs = append(attrs, attrStatusCode.Int(status))
+		span.SetAttributes(attrStatusCode.Int(status))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+
+	set := metric.WithAttributes(attrs...)
+	in.requests.Add(ctx, 1, set)
+	in.duration.Record(ctx, time.Since(start).Seconds(), set)
+}
+
+// recordAuthRefresh counts a token refresh and its outcome
+func (in *instrumentation) recordAuthRefresh(ctx context.Context, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+	in.authRefreshes.Add(ctx, 1, metric.WithAttributes(attrAuthOutcome.String(outcome)))
+}
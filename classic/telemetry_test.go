@@ -0,0 +1,170 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func telemetryResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/token":
+			exp := time.Now().Add(time.Minute * 10).Format(time.RFC3339)
+			fmt.Fprintf(w, `{"token": "telemetry-token", "expires": "%s"}`, exp)
+		case "/JSSResource/computers/serialnumber/C02TELEMETRY":
+			fmt.Fprint(w, `{"computer": {"general": {"id": 7, "name": "Traced Mac"}}}`)
+		case "/JSSResource/policies/id/404":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func findMetric(rm metricdata.ResourceMetrics, name string) (metricdata.Metrics, bool) {
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m, true
+			}
+		}
+	}
+	return metricdata.Metrics{}, false
+}
+
+func TestInstrumentation(t *testing.T) {
+	server := telemetryResponseMocks(t)
+	defer server.Close()
+
+	spans := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth(), jamf.WithInstrumentation(tp, mp))
+	assert.Nil(t, err)
+
+	computer, err := j.GetComputer(&jamf.ComputerIdentifier{SerialNumber: "C02TELEMETRY"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Traced Mac", computer.Info.General.Name)
+
+	_, err = j.PolicyDetails(404)
+	assert.NotNil(t, err)
+
+	// traces
+	ended := spans.GetSpans().Snapshots()
+	assert.Equal(t, 2, len(ended))
+
+	computerSpan := ended[0]
+	assert.Equal(t, "jamf.get computers", computerSpan.Name())
+	attrs := spanAttributes(computerSpan)
+	assert.Equal(t, "computers", attrs["jamf.resource"].AsString())
+	assert.Equal(t, "serialnumber", attrs["jamf.identifier_type"].AsString())
+	assert.Equal(t, "GET", attrs["http.request.method"].AsString())
+	assert.Equal(t, int64(200), attrs["http.response.status_code"].AsInt64())
+	assert.Equal(t, codes.Unset, computerSpan.Status().Code)
+
+	policySpan := ended[1]
+	assert.Equal(t, "jamf.get policies", policySpan.Name())
+	attrs = spanAttributes(policySpan)
+	assert.Equal(t, "policies", attrs["jamf.resource"].AsString())
+	assert.Equal(t, "id", attrs["jamf.identifier_type"].AsString())
+	assert.Equal(t, int64(404), attrs["http.response.status_code"].AsInt64())
+	assert.Equal(t, codes.Error, policySpan.Status().Code)
+
+	// metrics
+	rm := metricdata.ResourceMetrics{}
+	assert.Nil(t, reader.Collect(context.Background(), &rm))
+
+	requests, ok := findMetric(rm, "jamf.client.requests")
+	assert.True(t, ok)
+	sum := requests.Data.(metricdata.Sum[int64])
+	assert.Equal(t, 2, len(sum.DataPoints))
+	for _, dp := range sum.DataPoints {
+		assert.Equal(t, int64(1), dp.Value)
+		resource, _ := dp.Attributes.Value("jamf.resource")
+		status, _ := dp.Attributes.Value("http.response.status_code")
+		switch resource.AsString() {
+		case "computers":
+			assert.Equal(t, int64(200), status.AsInt64())
+		case "policies":
+			assert.Equal(t, int64(404), status.AsInt64())
+		default:
+			t.Errorf("unexpected resource %s", resource.AsString())
+		}
+	}
+
+	duration, ok := findMetric(rm, "jamf.client.request.duration")
+	assert.True(t, ok)
+	histogram := duration.Data.(metricdata.Histogram[float64])
+	assert.Equal(t, 2, len(histogram.DataPoints))
+	for _, dp := range histogram.DataPoints {
+		assert.Equal(t, uint64(1), dp.Count)
+	}
+
+	refreshes, ok := findMetric(rm, "jamf.client.auth.refreshes")
+	assert.True(t, ok)
+	refreshSum := refreshes.Data.(metricdata.Sum[int64])
+	assert.Equal(t, 1, len(refreshSum.DataPoints))
+	assert.Equal(t, int64(1), refreshSum.DataPoints[0].Value)
+	outcome, _ := refreshSum.DataPoints[0].Attributes.Value("jamf.auth.outcome")
+	assert.Equal(t, "success", outcome.AsString())
+}
+
+func TestInstrumentationProAPI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/token":
+			exp := time.Now().Add(time.Minute * 10).Format(time.RFC3339)
+			fmt.Fprintf(w, `{"token": "telemetry-token", "expires": "%s"}`, exp)
+		case "/api/v1/departments/1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	spans := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans))
+
+	// Do always uses a bearer token even when the client uses basic auth for the classic API
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithInstrumentation(tp, nil))
+	assert.Nil(t, err)
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/departments/1", server.URL), nil)
+	assert.Nil(t, err)
+	res, err := j.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+	assert.Equal(t, "Bearer telemetry-token", req.Header.Get("Authorization"))
+
+	ended := spans.GetSpans().Snapshots()
+	assert.Equal(t, 1, len(ended))
+	assert.Equal(t, "jamf.delete departments", ended[0].Name())
+	assert.Equal(t, "departments", spanAttributes(ended[0])["jamf.resource"].AsString())
+}
@@ -0,0 +1,213 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// tokenManager guards the bearer token shared by every request made by a client so
+// concurrent requests never race on a refresh and only one refresh is in flight at a time
+type tokenManager struct {
+	mu       sync.Mutex
+	token    *AuthToken
+	attempts int
+	lastErr  error
+	retryAt  time.Time
+	call     *tokenCall
+}
+
+// tokenCall is a token refresh in progress that concurrent requests wait on
+type tokenCall struct {
+	done  chan struct{}
+	token *AuthToken
+	err   error
+}
+
+func newTokenManager() *tokenManager {
+	return &tokenManager{token: &AuthToken{}}
+}
+
+// current returns the cached token
+func (m *tokenManager) current() *AuthToken {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.token
+}
+
+// store records the result of a token request, the attempt counter is reset on success. Cancelled
+// or timed out requests say nothing about the credentials so they aren't counted as failed attempts
+func (m *tokenManager) store(token *AuthToken, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return
+		}
+		m.attempts++
+		m.lastErr = err
+		if m.attempts >= maxAuthAttempts {
+			m.retryAt = time.Now().Add(authRetryBackoff)
+		}
+		return
+	}
+	m.token = token
+	m.attempts = 0
+	m.lastErr = nil
+}
+
+// clear drops the cached token if it is still the given one so the next request fetches a new token
+func (m *tokenManager) clear(token *AuthToken) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.token == token {
+		m.token = &AuthToken{}
+	}
+}
+
+// bearerToken returns a valid token refreshing it first when it is about to expire. Concurrent
+// callers share the same refresh and once maxAuthAttempts consecutive refreshes have failed the
+// last error is returned without contacting the server for authRetryBackoff or until GetAuthToken succeeds
+func (j *Client) bearerToken(ctx context.Context) (*AuthToken, error) {
+	m := j.tokens
+	m.mu.Lock()
+	token := m.token
+	expiring, err := token.ExpiresWithin(tokenRefreshWindow)
+	if err == nil && !expiring {
+		m.mu.Unlock()
+		return token, nil
+	}
+
+	c := m.call
+	if c == nil {
+		if m.attempts >= maxAuthAttempts && time.Now().Before(m.retryAt) {
+			lastErr := m.lastErr
+			m.mu.Unlock()
+			return nil, errors.Wrapf(lastErr, "unable to retrieve auth token after %d attempts", maxAuthAttempts)
+		}
+
+		c = &tokenCall{done: make(chan struct{})}
+		m.call = c
+		// the refresh is shared with every request waiting on it so it must not be cancelled
+		// along with the request which happened to start it
+		go j.sharedRefresh(context.WithoutCancel(ctx), token, c)
+	}
+	m.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.token, c.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// sharedRefresh refreshes the token on behalf of every request waiting on the call
+func (j *Client) sharedRefresh(ctx context.Context, current *AuthToken, c *tokenCall) {
+	ctx, cancel := context.WithTimeout(ctx, tokenRefreshTimeout)
+	defer cancel()
+
+	c.token, c.err = j.refreshToken(ctx, current)
+	j.tokens.store(c.token, c.err)
+	j.telemetry.recordAuthRefresh(ctx, c.err)
+
+	j.tokens.mu.Lock()
+	j.tokens.call = nil
+	j.tokens.mu.Unlock()
+	close(c.done)
+}
+
+// refreshToken renews a token that is about to expire using the keep-alive endpoint and
+// falls back to requesting a new token when it can't be renewed
+func (j *Client) refreshToken(ctx context.Context, current *AuthToken) (*AuthToken, error) {
+	if j.oauth == nil && current.Token != "" {
+		if expired, err := current.IsExpired(); err == nil && !expired {
+			if token, err := j.keepAlive(ctx, current); err == nil {
+				return token, nil
+			}
+		}
+	}
+	return j.fetchToken(ctx)
+}
+
+// fetchToken requests a new token using the configured credentials
+func (j *Client) fetchToken(ctx context.Context) (*AuthToken, error) {
+	if j.oauth != nil {
+		return j.getOAuthToken(ctx)
+	}
+	return j.getBasicAuthToken(ctx)
+}
+
+// keepAlive exchanges a valid token for a new one with a later expiration
+// https://developer.jamf.com/jamf-pro/reference/post_v1-auth-keep-alive
+func (j *Client) keepAlive(ctx context.Context, current *AuthToken) (*AuthToken, error) {
+	ep := fmt.Sprintf("%s/api/v1/auth/keep-alive", j.Domain)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", current.Token))
+
+	res, err := j.do(req, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error making %s request to %s", req.Method, req.URL)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		responseData, _ := io.ReadAll(res.Body)
+		return nil, errors.Wrapf(newAPIError(res, responseData), "error making %s request to %s", req.Method, req.URL)
+	}
+
+	var token AuthToken
+	if err = json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return nil, errors.Wrap(err, "response was successful but error occurred error decoding response body")
+	}
+
+	return &token, nil
+}
+
+// InvalidateAuthToken invalidates the current bearer token on the server and drops it from the client
+// https://developer.jamf.com/jamf-pro/reference/post_v1-auth-invalidate-token
+func (j *Client) InvalidateAuthToken() error {
+	return j.InvalidateAuthTokenContext(context.Background())
+}
+
+// InvalidateAuthTokenContext is like InvalidateAuthToken but uses the given context for the request
+func (j *Client) InvalidateAuthTokenContext(ctx context.Context) error {
+	token := j.tokens.current()
+	if token.Token == "" {
+		return nil
+	}
+
+	ep := fmt.Sprintf("%s/api/v1/auth/invalidate-token", j.Domain)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.Token))
+
+	res, err := j.do(req, true)
+	if err != nil {
+		return errors.Wrapf(err, "error making %s request to %s", req.Method, req.URL)
+	}
+	defer res.Body.Close()
+
+	// an already expired token can no longer be used so it is dropped either way
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK && res.StatusCode != http.StatusUnauthorized {
+		responseData, _ := io.ReadAll(res.Body)
+		return errors.Wrapf(newAPIError(res, responseData), "error making %s request to %s", req.Method, req.URL)
+	}
+
+	j.tokens.clear(token)
+	return nil
+}
@@ -0,0 +1,243 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+type tokenRequestCounts struct {
+	token      int32
+	keepAlive  int32
+	invalidate int32
+}
+
+// tokenLifecycleMocks serves tokens expiring after `lifetime` and rejects token requests while `unauthorized` is set
+func tokenLifecycleMocks(t *testing.T, lifetime time.Duration, unauthorized *atomic.Bool) (*httptest.Server, *tokenRequestCounts) {
+	counts := &tokenRequestCounts{}
+	var mu sync.Mutex
+	valid := map[string]bool{}
+	issue := func(w http.ResponseWriter, prefix string, n int32) {
+		token := fmt.Sprintf("%s-%d", prefix, n)
+		mu.Lock()
+		valid[token] = true
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"token": "%s", "expires": "%s"}`, token, time.Now().Add(lifetime).Format(time.RFC3339))
+	}
+	bearer := func(r *http.Request) (string, bool) {
+		var token string
+		_, err := fmt.Sscanf(r.Header.Get("Authorization"), "Bearer %s", &token)
+		mu.Lock()
+		defer mu.Unlock()
+		return token, err == nil && valid[token]
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/token":
+			n := atomic.AddInt32(&counts.token, 1)
+			if unauthorized != nil && unauthorized.Load() {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			// slow token responses make concurrent requests pile up on the refresh
+			time.Sleep(20 * time.Millisecond)
+			issue(w, "token", n)
+		case "/api/v1/auth/keep-alive":
+			n := atomic.AddInt32(&counts.keepAlive, 1)
+			if _, ok := bearer(r); !ok {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			issue(w, "renewed", n)
+		case "/api/v1/auth/invalidate-token":
+			atomic.AddInt32(&counts.invalidate, 1)
+			token, ok := bearer(r)
+			if !ok {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			mu.Lock()
+			delete(valid, token)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		case "/JSSResource/scripts":
+			token, ok := bearer(r)
+			if !ok {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprintf(w, `{"scripts": [{"id": 1, "name": "%s"}]}`, token)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	})), counts
+}
+
+func TestTokenConcurrentRefresh(t *testing.T) {
+	server, counts := tokenLifecycleMocks(t, 10*time.Minute, nil)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth())
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scripts, err := j.Scripts()
+			assert.Nil(t, err)
+			if assert.Equal(t, 1, len(scripts)) {
+				assert.Equal(t, "token-1", scripts[0].Name)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counts.token))
+	assert.Equal(t, "token-1", j.AuthToken().Token)
+}
+
+func TestTokenKeepAliveBeforeExpiry(t *testing.T) {
+	// tokens expiring within the refresh window are renewed using keep-alive
+	server, counts := tokenLifecycleMocks(t, 10*time.Second, nil)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth())
+	assert.Nil(t, err)
+
+	scripts, err := j.Scripts()
+	assert.Nil(t, err)
+	assert.Equal(t, "token-1", scripts[0].Name)
+
+	scripts, err = j.Scripts()
+	assert.Nil(t, err)
+	assert.Equal(t, "renewed-1", scripts[0].Name)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counts.token))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counts.keepAlive))
+}
+
+func TestTokenInvalidate(t *testing.T) {
+	server, counts := tokenLifecycleMocks(t, 10*time.Minute, nil)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth())
+	assert.Nil(t, err)
+
+	// nothing to invalidate before a token is retrieved
+	assert.Nil(t, j.InvalidateAuthToken())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&counts.invalidate))
+
+	assert.Nil(t, j.GetAuthToken())
+	assert.Equal(t, "token-1", j.AuthToken().Token)
+
+	assert.Nil(t, j.InvalidateAuthToken())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counts.invalidate))
+	assert.Equal(t, "", j.AuthToken().Token)
+
+	scripts, err := j.Scripts()
+	assert.Nil(t, err)
+	assert.Equal(t, "token-2", scripts[0].Name)
+}
+
+func TestTokenRejectedByServer(t *testing.T) {
+	server, counts := tokenLifecycleMocks(t, 10*time.Minute, nil)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth())
+	assert.Nil(t, err)
+
+	assert.Nil(t, j.GetAuthToken())
+
+	// invalidate the token behind the client's back
+	other, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth())
+	assert.Nil(t, err)
+	assert.Nil(t, other.GetAuthToken())
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/auth/invalidate-token", server.URL), nil)
+	assert.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer token-1")
+	res, err := server.Client().Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+
+	_, err = j.Scripts()
+	assert.True(t, errors.Is(err, jamf.ErrUnauthorized))
+
+	scripts, err := j.Scripts()
+	assert.Nil(t, err)
+	assert.Equal(t, "token-3", scripts[0].Name)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&counts.token))
+}
+
+func TestTokenMaxAuthAttempts(t *testing.T) {
+	var unauthorized atomic.Bool
+	unauthorized.Store(true)
+	server, counts := tokenLifecycleMocks(t, 10*time.Minute, &unauthorized)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth())
+	assert.Nil(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err = j.Scripts()
+		assert.True(t, errors.Is(err, jamf.ErrUnauthorized))
+	}
+	// the client stops asking for tokens once the max attempts are reached
+	assert.Equal(t, int32(3), atomic.LoadInt32(&counts.token))
+
+	// a successful explicit refresh resets the attempt counter
+	unauthorized.Store(false)
+	assert.Nil(t, j.GetAuthToken())
+	scripts, err := j.Scripts()
+	assert.Nil(t, err)
+	assert.Equal(t, "token-4", scripts[0].Name)
+}
+
+func TestTokenRefreshCancelled(t *testing.T) {
+	server, counts := tokenLifecycleMocks(t, 10*time.Minute, nil)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client(), jamf.WithTokenAuth())
+	assert.Nil(t, err)
+
+	// cancelled requests aren't counted as failed attempts
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 5; i++ {
+		assert.True(t, errors.Is(j.GetAuthTokenContext(cancelled), context.Canceled))
+	}
+	assert.Equal(t, int32(0), atomic.LoadInt32(&counts.token))
+
+	// the shared refresh outlives the request which started it so other requests still get the token
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := j.ScriptsContext(ctx)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	}()
+	time.Sleep(time.Millisecond)
+	scripts, err := j.Scripts()
+	wg.Wait()
+	assert.Nil(t, err)
+	assert.Equal(t, "token-1", scripts[0].Name)
+
+	for i := 0; i < 5; i++ {
+		_, err = j.ScriptsContext(cancelled)
+		assert.True(t, errors.Is(err, context.Canceled))
+	}
+	scripts, err = j.Scripts()
+	assert.Nil(t, err)
+	assert.Equal(t, "token-1", scripts[0].Name)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counts.token))
+}
@@ -0,0 +1,150 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Users returns a list of users available in Jamf
+func (j *Client) Users() ([]User, error) {
+	return j.UsersContext(context.Background())
+}
+
+// UsersContext is like Users but uses the given context for the request
+func (j *Client) UsersContext(ctx context.Context) ([]User, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, usersContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf users query request")
+	}
+	res := Users{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available users from %s", ep)
+	}
+	return res.List, nil
+}
+
+// UserDetails returns the details for a specific user given its ID or Name including the
+// computers and mobile devices linked to them
+func (j *Client) UserDetails(identifier any) (*UserDetails, error) {
+	return j.UserDetailsContext(context.Background(), identifier)
+}
+
+// UserDetailsContext is like UserDetails but uses the given context for the request
+func (j *Client) UserDetailsContext(ctx context.Context, identifier any) (*UserDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, usersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for user: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for user: %v", identifier)
+	}
+
+	res := userResponse{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query user: %v from %s", identifier, ep)
+	}
+	return &res.Details, nil
+}
+
+// UpdateUser will update a user in Jamf by either ID or Name
+func (j *Client) UpdateUser(identifier any, user *UserDetails) (*UserDetails, error) {
+	return j.UpdateUserContext(context.Background(), identifier, user)
+}
+
+// UpdateUserContext is like UpdateUser but uses the given context for the request
+func (j *Client) UpdateUserContext(ctx context.Context, identifier any, user *UserDetails) (*UserDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, usersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for user: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(user)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for user: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for user: %v (%s)", identifier, ep)
+	}
+
+	res := UserDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for user: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateUser will create a user in Jamf
+func (j *Client) CreateUser(user *UserDetails) (*UserDetails, error) {
+	return j.CreateUserContext(context.Background(), user)
+}
+
+// CreateUserContext is like CreateUser but uses the given context for the request
+func (j *Client) CreateUserContext(ctx context.Context, user *UserDetails) (*UserDetails, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, usersContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF query request for new user")
+	}
+
+	if user.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new user"), "unable to process JAMF creation request for user: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(user)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for user: %v", user.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for user: %v (%s)", user.Name, ep)
+	}
+
+	res := UserDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for user: %v (%s)", user.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteUser will delete a user by either ID or Name
+func (j *Client) DeleteUser(identifier any) (*UserDetails, error) {
+	return j.DeleteUserContext(context.Background(), identifier)
+}
+
+// DeleteUserContext is like DeleteUser but uses the given context for the request
+func (j *Client) DeleteUserContext(ctx context.Context, identifier any) (*UserDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, usersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for user: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for user: %v (%s)", identifier, ep)
+	}
+
+	res := UserDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for user: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "encoding/xml"
+
+// Users represents a list of users in Jamf
+type Users struct {
+	List []User `json:"users" xml:"user,omitempty"`
+	Size int    `json:"size" xml:"size"`
+}
+
+// UserDetails holds the details of a user configured in Jamf along with the computers
+// and mobile devices linked to them
+type UserDetails struct {
+	XMLName              xml.Name             `json:"-" xml:"user,omitempty"`
+	ID                   int                  `json:"id,omitempty" xml:"id,omitempty"`
+	Name                 string               `json:"name" xml:"name,omitempty"`
+	FullName             string               `json:"full_name" xml:"full_name,omitempty"`
+	Email                string               `json:"email" xml:"email,omitempty"`
+	EmailAddress         string               `json:"email_address" xml:"email_address,omitempty"`
+	PhoneNumber          string               `json:"phone_number" xml:"phone_number,omitempty"`
+	Position             string               `json:"position" xml:"position,omitempty"`
+	EnableCustomPhotoURL bool                 `json:"enable_custom_photo_url" xml:"enable_custom_photo_url,omitempty"`
+	CustomPhotoURL       string               `json:"custom_photo_url" xml:"custom_photo_url,omitempty"`
+	LDAPServer           *UserLDAPServer      `json:"ldap_server,omitempty" xml:"ldap_server,omitempty"`
+	ExtensionAttributes  []ExtensionAttribute `json:"extension_attributes" xml:"extension_attributes>extension_attribute,omitempty"`
+	Sites                []Site               `json:"sites" xml:"sites>site,omitempty"`
+	Links                *UserLinks           `json:"links,omitempty" xml:"links,omitempty"`
+}
+
+// UserLDAPServer holds the LDAP server a user was imported from
+type UserLDAPServer struct {
+	ID   int    `json:"id" xml:"id"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// UserLinks holds the computers and mobile devices assigned to a user in Jamf
+type UserLinks struct {
+	Computers         []BasicComputerInfo        `json:"computers" xml:"computers>computer,omitempty"`
+	MobileDevices     []GeneralDeviceInformation `json:"mobile_devices" xml:"mobile_devices>mobile_device,omitempty"`
+	TotalVPPCodeCount int                        `json:"total_vpp_code_count" xml:"total_vpp_code_count,omitempty"`
+}
+
+// userResponse unwraps the user returned by the classic API
+type userResponse struct {
+	Details UserDetails `json:"user"`
+}
+
+// UnmarshalXML decodes the user element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (u *userResponse) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&u.Details, &start)
+}
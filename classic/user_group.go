@@ -0,0 +1,199 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// UserGroups returns a list of user groups in Jamf
+func (j *Client) UserGroups() ([]BasicUserGroupInfo, error) {
+	return j.UserGroupsContext(context.Background())
+}
+
+// UserGroupsContext is like UserGroups but uses the given context for the request
+func (j *Client) UserGroupsContext(ctx context.Context) ([]BasicUserGroupInfo, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, userGroupsContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building Jamf user groups query request")
+	}
+	res := UserGroups{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available user groups from %s", ep)
+	}
+	return filterBySite(ctx, j, res.List, func(ctx context.Context, item BasicUserGroupInfo) error {
+		_, err := j.UserGroupDetailsContext(ctx, item.ID)
+		return err
+	})
+}
+
+// UserGroupDetails returns the details for a specific group given its ID or Name
+func (j *Client) UserGroupDetails(identifier any) (*UserGroup, error) {
+	return j.UserGroupDetailsContext(context.Background(), identifier)
+}
+
+// UserGroupDetailsContext is like UserGroupDetails but uses the given context for the request
+func (j *Client) UserGroupDetailsContext(ctx context.Context, identifier any) (*UserGroup, error) {
+	ep, err := EndpointBuilder(j.Endpoint, userGroupsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request endpoint for user group: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for user group: %v", identifier)
+	}
+
+	res := UserGroup{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query user group: %v from %s", identifier, ep)
+	}
+	var site *Site
+	if res.Info != nil {
+		site = res.Info.Site
+	}
+	if err := j.checkSite(site); err != nil {
+		return nil, errors.Wrapf(err, "unable to query user group: %v from %s", identifier, ep)
+	}
+	return &res, nil
+}
+
+// UpdateUserGroup will update the name, site and criteria of a user group in Jamf by either group ID or group Name
+func (j *Client) UpdateUserGroup(identifier any, updates *UserGroupDetails) (*UserGroupDetails, error) {
+	return j.UpdateUserGroupContext(context.Background(), identifier, updates)
+}
+
+// UpdateUserGroupContext is like UpdateUserGroup but uses the given context for the request
+func (j *Client) UpdateUserGroupContext(ctx context.Context, identifier any, updates *UserGroupDetails) (*UserGroupDetails, error) {
+	// groups can't be moved out of the site the client is pinned to
+	if _, err := j.clientSite(updates.Site); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for user group: %v", identifier)
+	}
+	return j.putUserGroup(ctx, identifier, updates)
+}
+
+// UpdateUserGroupMembers will update the members of a static user group in Jamf by either group ID or group Name
+func (j *Client) UpdateUserGroupMembers(identifier any, updates *UserGroupBindingChanges) (*UserGroupDetails, error) {
+	return j.UpdateUserGroupMembersContext(context.Background(), identifier, updates)
+}
+
+// UpdateUserGroupMembersContext is like UpdateUserGroupMembers but uses the given context for the request
+func (j *Client) UpdateUserGroupMembersContext(ctx context.Context, identifier any, updates *UserGroupBindingChanges) (*UserGroupDetails, error) {
+	return j.putUserGroup(ctx, identifier, updates)
+}
+
+func (j *Client) putUserGroup(ctx context.Context, identifier any, updates any) (*UserGroupDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, userGroupsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for user group: %v", identifier)
+	}
+
+	if err := checkExistingSite(ctx, j, identifier, j.UserGroupDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for user group: %v (%s)", identifier, ep)
+	}
+
+	bodyContent, err := xml.Marshal(updates)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for user group: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for user group: %v (%s)", identifier, ep)
+	}
+
+	res := UserGroupDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for user group: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateUserGroup will create a static or smart user group in Jamf
+func (j *Client) CreateUserGroup(newGroup *UserGroupDetails) (*UserGroupDetails, error) {
+	return j.CreateUserGroupContext(context.Background(), newGroup)
+}
+
+// CreateUserGroupContext is like CreateUserGroup but uses the given context for the request
+func (j *Client) CreateUserGroupContext(ctx context.Context, newGroup *UserGroupDetails) (*UserGroupDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, userGroupsContext, -1)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF add user group request endpoint")
+	}
+
+	if newGroup.Name == "" {
+		return nil, errors.New("error building JAMF add user group request: group name is required")
+	}
+
+	if !newGroup.IsSmart && len(newGroup.Criteria) > 0 {
+		return nil, errors.New("error building JAMF add user group request: criteria can only be set on smart groups")
+	}
+
+	if newGroup.IsSmart && len(newGroup.Users) > 0 {
+		return nil, errors.New("error building JAMF add user group request: users can only be set on static groups")
+	}
+
+	site, err := j.clientSite(newGroup.Site)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF add user group request")
+	}
+	group := *newGroup
+	group.Site = site
+
+	bodyContent, err := xml.Marshal(&group)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF add user group payload")
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF add user group request")
+	}
+
+	res := UserGroupDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrap(err, "unable to process JAMF add user group request")
+	}
+
+	return &res, nil
+}
+
+// DeleteUserGroup will delete a user group by either ID or Name
+func (j *Client) DeleteUserGroup(identifier any) (*UserGroupDetails, error) {
+	return j.DeleteUserGroupContext(context.Background(), identifier)
+}
+
+// DeleteUserGroupContext is like DeleteUserGroup but uses the given context for the request
+func (j *Client) DeleteUserGroupContext(ctx context.Context, identifier any) (*UserGroupDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, userGroupsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF delete user group request endpoint for group: %v", identifier)
+	}
+
+	if err := checkExistingSite(ctx, j, identifier, j.UserGroupDetailsContext); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF delete user group request for group: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF delete user group request for group: %v", identifier)
+	}
+
+	res := UserGroupDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF delete user group request for group: %v", identifier)
+	}
+
+	return &res, nil
+}
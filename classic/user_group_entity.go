@@ -0,0 +1,45 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package classic
+
+import "encoding/xml"
+
+// UserGroups represents a list of user groups in Jamf
+type UserGroups struct {
+	List []BasicUserGroupInfo `json:"user_groups" xml:"user_group,omitempty"`
+	Size int                  `json:"size" xml:"size"`
+}
+
+// BasicUserGroupInfo represents the information returned in a list of all user groups from Jamf
+type BasicUserGroupInfo struct {
+	ID             int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name           string `json:"name" xml:"name"`
+	IsSmart        bool   `json:"is_smart" xml:"is_smart"`
+	NotifyOnChange bool   `json:"is_notify_on_change" xml:"is_notify_on_change"`
+}
+
+// UserGroupMember represents a user who is a member of a user group. Either the ID or
+// Username is enough to reference a user when adding or removing members
+type UserGroupMember struct {
+	ID           int    `json:"id,omitempty" xml:"id,omitempty"`
+	Username     string `json:"username,omitempty" xml:"username,omitempty"`
+	FullName     string `json:"full_name,omitempty" xml:"full_name,omitempty"`
+	PhoneNumber  string `json:"phone_number,omitempty" xml:"phone_number,omitempty"`
+	EmailAddress string `json:"email_address,omitempty" xml:"email_address,omitempty"`
+}
+
+// UserGroupBindingChanges represents the changes to a user group binding when
+// updating the members of a static user group in Jamf
+type UserGroupBindingChanges struct {
+	XMLName   xml.Name          `json:"-" xml:"user_group,omitempty"`
+	Additions []UserGroupMember `xml:"user_additions>user"`
+	Removals  []UserGroupMember `xml:"user_deletions>user"`
+}
+
+// UnmarshalXML decodes the user_group element returned when the classic API responds with XML
+// since unlike the JSON response it isn't wrapped in a parent object
+func (g *UserGroup) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	g.Info = &UserGroupDetails{}
+	return dec.DecodeElement(g.Info, &start)
+}
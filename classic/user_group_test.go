@@ -0,0 +1,231 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var USER_GROUPS_BASE_API_ENDPOINT = "/JSSResource/usergroups"
+
+func userGroupsResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case USER_GROUPS_BASE_API_ENDPOINT:
+			fmt.Fprint(w, `{
+				"user_groups": [
+					{
+						"id": 1,
+						"name": "Engineering",
+						"is_smart": false,
+						"is_notify_on_change": false
+					},
+					{
+						"id": 2,
+						"name": "Contractors",
+						"is_smart": true,
+						"is_notify_on_change": true
+					}]
+				}`)
+		case fmt.Sprintf("%s/id/1", USER_GROUPS_BASE_API_ENDPOINT), fmt.Sprintf("%s/name/Engineering", USER_GROUPS_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			switch r.Method {
+			case "GET", "DELETE":
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+					<user_group>
+						<id>1</id>
+						<name>Engineering</name>
+						<is_smart>false</is_smart>
+						<is_notify_on_change>false</is_notify_on_change>
+						<site><id>-1</id><name>None</name></site>
+						<criteria><size>0</size></criteria>
+						<users>
+							<size>1</size>
+							<user>
+								<id>1</id>
+								<username>jdoe</username>
+								<full_name>Jane Doe</full_name>
+								<phone_number/>
+								<email_address>jdoe@example.com</email_address>
+							</user>
+						</users>
+					</user_group>`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				assert.Equal(t, `<user_group><user_additions><user><username>asmith</username></user></user_additions><user_deletions><user><id>1</id></user></user_deletions></user_group>`, string(data))
+				group := &jamf.UserGroupDetails{
+					ID:    1,
+					Name:  "Engineering",
+					Users: []jamf.UserGroupMember{{ID: 2, Username: "asmith"}},
+				}
+				groupData, err := xml.MarshalIndent(group, "", "  ")
+				assert.Nil(t, err)
+				fmt.Fprint(w, string(groupData))
+			}
+		case fmt.Sprintf("%s/id/2", USER_GROUPS_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{
+					"user_group": {
+						"id": 2,
+						"name": "Contractors",
+						"is_smart": true,
+						"is_notify_on_change": true,
+						"site": {"id": -1, "name": "None"},
+						"criteria": [
+							{
+								"name": "Email Address",
+								"priority": 0,
+								"and_or": "and",
+								"search_type": "like",
+								"value": "@contractor.example.com",
+								"opening_paren": false,
+								"closing_paren": false
+							}
+						],
+						"users": [{"id": 4, "username": "cwhite", "full_name": "Casey White", "phone_number": "", "email_address": "cwhite@contractor.example.com"}]
+					}
+				}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, string(data))
+			}
+		case fmt.Sprintf("%s/id/-1", USER_GROUPS_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			group := &jamf.UserGroupDetails{}
+			assert.Nil(t, xml.Unmarshal(data, group))
+			group.ID = 3
+			groupData, err := xml.MarshalIndent(group, "", "  ")
+			assert.Nil(t, err)
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, string(groupData))
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestListAllUserGroups(t *testing.T) {
+	server := userGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+	grps, err := j.UserGroups()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(grps))
+	assert.Equal(t, "Engineering", grps[0].Name)
+	assert.Equal(t, false, grps[0].IsSmart)
+	assert.Equal(t, true, grps[1].IsSmart)
+	assert.Equal(t, true, grps[1].NotifyOnChange)
+}
+
+func TestQuerySpecificUserGroups(t *testing.T) {
+	server := userGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+
+	// static group as XML
+	grp, err := j.UserGroupDetails("Engineering")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, grp.Info.ID)
+	assert.Equal(t, false, grp.Info.IsSmart)
+	assert.Equal(t, "None", grp.Info.Site.Name)
+	assert.Equal(t, 0, len(grp.Info.Criteria))
+	assert.Equal(t, "jdoe", grp.Info.Users[0].Username)
+	assert.Equal(t, "jdoe@example.com", grp.Info.Users[0].EmailAddress)
+
+	// smart group as JSON
+	grp, err = j.UserGroupDetails(2)
+	assert.Nil(t, err)
+	assert.Equal(t, true, grp.Info.IsSmart)
+	assert.Equal(t, []jamf.Criterion{
+		{Name: "Email Address", Priority: 0, AndOr: jamf.CriterionAnd, SearchType: jamf.SearchTypeLike, Value: "@contractor.example.com"},
+	}, grp.Info.Criteria)
+	assert.Equal(t, "Casey White", grp.Info.Users[0].FullName)
+}
+
+func TestCreateUserGroup(t *testing.T) {
+	server := userGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+
+	grp := &jamf.UserGroupDetails{
+		Name:    "Finance",
+		IsSmart: true,
+		Criteria: []jamf.Criterion{
+			{Name: "Department", Priority: 0, AndOr: jamf.CriterionAnd, SearchType: jamf.SearchTypeIs, Value: "Finance"},
+		},
+	}
+	createdGrp, err := j.CreateUserGroup(grp)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, createdGrp.ID)
+	assert.Equal(t, "Finance", createdGrp.Name)
+	assert.Equal(t, grp.Criteria, createdGrp.Criteria)
+
+	createdGrp, err = j.CreateUserGroup(&jamf.UserGroupDetails{
+		Name:  "On Call",
+		Users: []jamf.UserGroupMember{{ID: 1}, {Username: "asmith"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, false, createdGrp.IsSmart)
+	assert.Equal(t, "asmith", createdGrp.Users[1].Username)
+
+	_, err = j.CreateUserGroup(&jamf.UserGroupDetails{})
+	assert.NotNil(t, err)
+
+	_, err = j.CreateUserGroup(&jamf.UserGroupDetails{Name: "Static", Criteria: grp.Criteria})
+	assert.NotNil(t, err)
+
+	_, err = j.CreateUserGroup(&jamf.UserGroupDetails{Name: "Smart", IsSmart: true, Users: []jamf.UserGroupMember{{ID: 1}}})
+	assert.NotNil(t, err)
+}
+
+func TestUpdateUserGroup(t *testing.T) {
+	server := userGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+
+	grp, err := j.UpdateUserGroupMembers("Engineering", &jamf.UserGroupBindingChanges{
+		Additions: []jamf.UserGroupMember{{Username: "asmith"}},
+		Removals:  []jamf.UserGroupMember{{ID: 1}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(grp.Users))
+	assert.Equal(t, "asmith", grp.Users[0].Username)
+
+	grp, err = j.UpdateUserGroup(2, &jamf.UserGroupDetails{
+		Name:    "Contractors",
+		IsSmart: true,
+		Criteria: []jamf.Criterion{
+			{Name: "Email Address", Priority: 0, AndOr: jamf.CriterionAnd, SearchType: jamf.SearchTypeLike, Value: "@vendor.example.com"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "@vendor.example.com", grp.Criteria[0].Value)
+}
+
+func TestDeleteUserGroup(t *testing.T) {
+	server := userGroupsResponseMocks(t)
+	defer server.Close()
+	j, err := jamf.NewClient(server.URL, "test", "test", server.Client())
+	assert.Nil(t, err)
+
+	grp, err := j.DeleteUserGroup(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, grp.ID)
+}
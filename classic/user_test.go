@@ -0,0 +1,160 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package classic_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var USERS_BASE_API_ENDPOINT = "/JSSResource/users"
+
+func usersResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case USERS_BASE_API_ENDPOINT:
+			fmt.Fprint(w, `{
+				"users": [
+					{
+						"id": 1,
+						"name": "jdoe"
+					},
+					{
+						"id": 2,
+						"name": "asmith"
+					}]
+				}`)
+		case fmt.Sprintf("%s/id/1", USERS_BASE_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{
+					"user": {
+						"id": 1,
+						"name": "jdoe",
+						"full_name": "Jane Doe",
+						"email": "jdoe@example.com",
+						"email_address": "jdoe@example.com",
+						"phone_number": "555-0100",
+						"position": "Engineer",
+						"enable_custom_photo_url": false,
+						"custom_photo_url": "",
+						"ldap_server": {"id": -1, "name": "None"},
+						"extension_attributes": [{"id": 1, "name": "Cost Center", "type": "String", "value": "R&D"}],
+						"sites": [{"id": 1, "name": "Boston"}],
+						"links": {
+							"computers": [{"id": 12, "name": "Jane's MacBook Pro"}],
+							"peripherals": [],
+							"mobile_devices": [{"id": 7, "name": "Jane's iPhone"}],
+							"vpp_assignments": [],
+							"total_vpp_code_count": 0
+						}
+					}
+				}`)
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				assert.Nil(t, err)
+				user := &jamf.UserDetails{}
+				assert.Nil(t, xml.Unmarshal(data, user))
+				assert.Equal(t, "Staff Engineer", user.Position)
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><user><id>1</id></user>`)
+			case "DELETE":
+				w.Header().Add("Content-Type", "application/xml")
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><user><id>1</id></user>`)
+			}
+		case fmt.Sprintf("%s/name/asmith", USERS_BASE_API_ENDPOINT):
+			w.Header().Add("Content-Type", "application/xml")
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+				<user>
+					<id>2</id>
+					<name>asmith</name>
+					<full_name>Alex Smith</full_name>
+					<email_address>asmith@example.com</email_address>
+					<ldap_server><id>1</id><name>Corp AD</name></ldap_server>
+					<extension_attributes/>
+					<sites><site><id>-1</id><name>None</name></site></sites>
+					<links>
+						<computers>
+							<computer><id>14</id><name>Alex's MacBook Air</name></computer>
+							<computer><id>15</id><name>Alex's Mac mini</name></computer>
+						</computers>
+						<peripherals/>
+						<mobile_devices>
+							<mobile_device><id>9</id><name>Alex's iPad</name></mobile_device>
+						</mobile_devices>
+						<vpp_assignments/>
+						<total_vpp_code_count>0</total_vpp_code_count>
+					</links>
+				</user>`)
+		case fmt.Sprintf("%s/id/-1", USERS_BASE_API_ENDPOINT):
+			data, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, `<user><name>bjones</name><full_name>Bo Jones</full_name><email>bjones@example.com</email><extension_attributes></extension_attributes><sites></sites></user>`, string(data))
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><user><id>3</id></user>`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf users API call to %s", r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestUsers(t *testing.T) {
+	testServer := usersResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	users, err := j.Users()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(users))
+	assert.Equal(t, "asmith", users[1].Name)
+
+	// JSON
+	user, err := j.UserDetails(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "Jane Doe", user.FullName)
+	assert.Equal(t, "jdoe@example.com", user.EmailAddress)
+	assert.Equal(t, "R&D", user.ExtensionAttributes[0].Value)
+	assert.Equal(t, "Boston", user.Sites[0].Name)
+	assert.Equal(t, 12, user.Links.Computers[0].ID)
+	assert.Equal(t, "Jane's iPhone", user.Links.MobileDevices[0].Name)
+
+	// XML
+	user, err = j.UserDetails("asmith")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, user.ID)
+	assert.Equal(t, "Corp AD", user.LDAPServer.Name)
+	assert.Equal(t, 2, len(user.Links.Computers))
+	assert.Equal(t, "Alex's Mac mini", user.Links.Computers[1].Name)
+	assert.Equal(t, 9, user.Links.MobileDevices[0].ID)
+}
+
+func TestCreateUpdateDeleteUser(t *testing.T) {
+	testServer := usersResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	_, err = j.CreateUser(&jamf.UserDetails{FullName: "Bo Jones"})
+	assert.NotNil(t, err)
+
+	user, err := j.CreateUser(&jamf.UserDetails{Name: "bjones", FullName: "Bo Jones", Email: "bjones@example.com"})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, user.ID)
+
+	user, err = j.UpdateUser(1, &jamf.UserDetails{Position: "Staff Engineer"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, user.ID)
+
+	user, err = j.DeleteUser(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, user.ID)
+}
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 )
 
 // JSONPrettyPrint can be used to pretty print JSON API responses
@@ -19,12 +20,13 @@ func JSONPrettyPrint(input []byte) string {
 	return out.String()
 }
 
-// EndpointBuilder can be utilized to query a specific API context via either name or ID
+// EndpointBuilder can be utilized to query a specific API context via either name or ID. Names are
+// escaped so characters such as # or / aren't treated as part of the URL
 func EndpointBuilder(endpoint string, context string, identifier interface{}) (string, error) {
 	var ep string
-	switch identifier.(type) {
+	switch id := identifier.(type) {
 	case string:
-		ep = fmt.Sprintf("%s/%s/name/%s", endpoint, context, identifier)
+		ep = fmt.Sprintf("%s/%s/name/%s", endpoint, context, url.PathEscape(id))
 	case int:
 		ep = fmt.Sprintf("%s/%s/id/%d", endpoint, context, identifier)
 	default:
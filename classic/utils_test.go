@@ -22,6 +22,12 @@ func TestEndpointBuilderName(t *testing.T) {
 	assert.Equal(t, expected, result)
 }
 
+func TestEndpointBuilderNameEscaped(t *testing.T) {
+	result, err := jamf.EndpointBuilder(testDomain, testContext, "Dev #1/Tools")
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("%s/%s/name/Dev%%20%%231%%2FTools", testDomain, testContext), result)
+}
+
 func TestEndpointBuilderID(t *testing.T) {
 	id := 87
 	expected := fmt.Sprintf("%s/%s/id/%d", testDomain, testContext, id)
@@ -0,0 +1,113 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+// Package pro is a client for the Jamf Pro API (/api/v1, /api/v2...). Requests are sent through a
+// classic.Client so authentication, retries, rate limiting, middleware, logging and instrumentation
+// are shared with the classic API client
+package pro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/pkg/errors"
+)
+
+const (
+	computersInventoryContext       = "v1/computers-inventory"
+	computersInventoryDetailContext = "v1/computers-inventory-detail"
+	departmentsContext              = "v1/departments"
+	mobileDevicesContext            = "v2/mobile-devices"
+	packagesContext                 = "v1/packages"
+)
+
+// Client represents the interface used to communicate with the Jamf Pro API
+type Client struct {
+	Domain   string
+	Endpoint string
+	classic  *classic.Client
+}
+
+// NewClient returns a new Jamf Pro API client. The options are the same as the ones accepted by
+// classic.NewClient, bearer token auth is always used since the Jamf Pro API does not accept basic auth
+func NewClient(domain string, username string, password string, client *http.Client, opts ...classic.Option) (*Client, error) {
+	opts = append(opts[:len(opts):len(opts)], classic.WithTokenAuth())
+	c, err := classic.NewClient(domain, username, password, client, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientFromClassic(c), nil
+}
+
+// NewClientFromClassic returns a Jamf Pro API client sharing the auth token and transport of an existing classic client
+func NewClientFromClassic(c *classic.Client) *Client {
+	domain := strings.TrimSuffix(c.Domain, "/")
+	return &Client{
+		Domain:   domain,
+		Endpoint: fmt.Sprintf("%s/api", domain),
+		classic:  c,
+	}
+}
+
+// Classic returns the classic API client used to send requests
+func (j *Client) Classic() *classic.Client {
+	return j.classic
+}
+
+// makeAPIrequest sends a JSON request to the given path relative to the API endpoint and decodes the response into v
+func (j *Client) makeAPIrequest(ctx context.Context, method string, path string, query url.Values, body interface{}, v interface{}) error {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, path)
+	if len(query) > 0 {
+		ep = fmt.Sprintf("%s?%s", ep, query.Encode())
+	}
+
+	var reader io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrapf(err, "error building JAMF %s payload for %s", method, ep)
+		}
+		reader = bytes.NewReader(content)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, ep, reader)
+	if err != nil {
+		return errors.Wrapf(err, "error building JAMF %s request for %s", method, ep)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return j.send(req, v)
+}
+
+// send sends the request through the classic client and decodes the JSON response into v
+func (j *Client) send(req *http.Request, v interface{}) error {
+	res, err := j.classic.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if v == nil || res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	if err = json.NewDecoder(res.Body).Decode(v); err != nil {
+		return errors.Wrapf(err, "response was successful but error occurred decoding response body from %s", req.URL)
+	}
+	return nil
+}
+
+// resourcePath returns the path of a single resource given its ID
+func resourcePath(context string, id string, extra ...string) string {
+	return strings.Join(append([]string{context, url.PathEscape(id)}, extra...), "/")
+}
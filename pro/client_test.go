@@ -0,0 +1,73 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package pro_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+// authenticated wraps a mock handler so requests without the bearer token are rejected
+func authenticated(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/auth/token" {
+			exp := time.Now().Add(time.Minute * 10).Format(time.RFC3339)
+			fmt.Fprintf(w, `{"token": "pro-token", "expires": "%s"}`, exp)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer pro-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}))
+}
+
+func TestNewClient(t *testing.T) {
+	testServer := authenticated(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"httpStatus": 404, "errors": [{"code": "INVALID_ID", "description": "Department not found", "id": "0", "field": null}]}`)
+	})
+	defer testServer.Close()
+
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("%s/api", testServer.URL), j.Endpoint)
+	assert.NotNil(t, j.Classic())
+
+	_, err = j.DepartmentDetails("404")
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, classic.ErrNotFound))
+	var apiErr *classic.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Department not found", apiErr.Message)
+
+	_, err = pro.NewClient(testServer.URL, "", "mock-password-cool", nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, "you must provide a valid Jamf domain, username, and password", err.Error())
+}
+
+func TestNewClientFromClassic(t *testing.T) {
+	testServer := authenticated(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1", "name": "Engineering"}`)
+	})
+	defer testServer.Close()
+
+	// the classic client uses basic auth but requests to the Jamf Pro API always use a bearer token
+	c, err := classic.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	j := pro.NewClientFromClassic(c)
+
+	department, err := j.DepartmentDetails("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Engineering", department.Name)
+	assert.Equal(t, "pro-token", c.AuthToken().Token)
+}
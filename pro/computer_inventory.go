@@ -0,0 +1,270 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package pro
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/pkg/errors"
+)
+
+// sectionValues returns the query parameters selecting the sections of a computer's inventory
+func sectionValues(sections []ComputerInventorySection) url.Values {
+	v := url.Values{}
+	for _, s := range sections {
+		v.Add("section", string(s))
+	}
+	return v
+}
+
+// values returns the query parameters for the options
+func (o *ComputerInventoryOptions) values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	v := o.ListOptions.values()
+	for k, s := range sectionValues(o.Sections) {
+		v[k] = s
+	}
+	return v
+}
+
+// listOptions returns the pagination options, nil options are left to the defaults
+func (o *ComputerInventoryOptions) listOptions() *ListOptions {
+	if o == nil {
+		return nil
+	}
+	return &o.ListOptions
+}
+
+// ComputersInventory returns a single page of computers from the computers inventory
+// https://developer.jamf.com/jamf-pro/reference/get_v1-computers-inventory
+func (j *Client) ComputersInventory(opts *ComputerInventoryOptions) (*Page[ComputerInventory], error) {
+	return j.ComputersInventoryContext(context.Background(), opts)
+}
+
+// ComputersInventoryContext is like ComputersInventory but uses the given context for the request
+func (j *Client) ComputersInventoryContext(ctx context.Context, opts *ComputerInventoryOptions) (*Page[ComputerInventory], error) {
+	res, err := listPage[ComputerInventory](ctx, j, computersInventoryContext, opts.values())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to query computers inventory")
+	}
+	return res, nil
+}
+
+// AllComputersInventory returns every computer in the computers inventory matching the options
+func (j *Client) AllComputersInventory(opts *ComputerInventoryOptions) ([]ComputerInventory, error) {
+	return j.AllComputersInventoryContext(context.Background(), opts)
+}
+
+// AllComputersInventoryContext is like AllComputersInventory but uses the given context for the requests
+func (j *Client) AllComputersInventoryContext(ctx context.Context, opts *ComputerInventoryOptions) ([]ComputerInventory, error) {
+	res, err := listAll[ComputerInventory](ctx, j, computersInventoryContext, opts.listOptions(), sectionValues(opts.sections()))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to query computers inventory")
+	}
+	return res, nil
+}
+
+// IterComputersInventory returns an iterator over every computer in the computers inventory matching the
+// options. Pages are fetched lazily as the iteration progresses so large fleets can be processed without
+// holding every computer in memory, the iteration stops after yielding the first error
+func (j *Client) IterComputersInventory(opts *ComputerInventoryOptions) iter.Seq2[ComputerInventory, error] {
+	return j.IterComputersInventoryContext(context.Background(), opts)
+}
+
+// IterComputersInventoryContext is like IterComputersInventory but uses the given context for the requests
+func (j *Client) IterComputersInventoryContext(ctx context.Context, opts *ComputerInventoryOptions) iter.Seq2[ComputerInventory, error] {
+	computers := iterate[ComputerInventory](ctx, j, computersInventoryContext, opts.listOptions(), sectionValues(opts.sections()))
+	return func(yield func(ComputerInventory, error) bool) {
+		for computer, err := range computers {
+			if err != nil {
+				err = errors.Wrap(err, "unable to query computers inventory")
+			}
+			if !yield(computer, err) {
+				return
+			}
+		}
+	}
+}
+
+// sections returns the requested sections
+func (o *ComputerInventoryOptions) sections() []ComputerInventorySection {
+	if o == nil {
+		return nil
+	}
+	return o.Sections
+}
+
+// ComputerInventoryDetails returns the inventory of a computer given its ID. Only the GENERAL section
+// is returned unless other sections are requested
+// https://developer.jamf.com/jamf-pro/reference/get_v1-computers-inventory-id
+func (j *Client) ComputerInventoryDetails(id string, sections ...ComputerInventorySection) (*ComputerInventory, error) {
+	return j.ComputerInventoryDetailsContext(context.Background(), id, sections...)
+}
+
+// ComputerInventoryDetailsContext is like ComputerInventoryDetails but uses the given context for the request
+func (j *Client) ComputerInventoryDetailsContext(ctx context.Context, id string, sections ...ComputerInventorySection) (*ComputerInventory, error) {
+	res := ComputerInventory{}
+	if err := j.makeAPIrequest(ctx, "GET", resourcePath(computersInventoryContext, id), sectionValues(sections), nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query computer inventory with ID: %s", id)
+	}
+	return &res, nil
+}
+
+// UpdateComputerInventory updates the inventory of a computer given its ID
+// https://developer.jamf.com/jamf-pro/reference/patch_v1-computers-inventory-detail-id
+func (j *Client) UpdateComputerInventory(id string, updates *ComputerInventoryUpdate) (*ComputerInventory, error) {
+	return j.UpdateComputerInventoryContext(context.Background(), id, updates)
+}
+
+// UpdateComputerInventoryContext is like UpdateComputerInventory but uses the given context for the request
+func (j *Client) UpdateComputerInventoryContext(ctx context.Context, id string, updates *ComputerInventoryUpdate) (*ComputerInventory, error) {
+	res := ComputerInventory{}
+	if err := j.makeAPIrequest(ctx, "PATCH", resourcePath(computersInventoryDetailContext, id), nil, updates, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for computer inventory with ID: %s", id)
+	}
+	return &res, nil
+}
+
+// DeleteComputerInventory deletes a computer given its ID
+// https://developer.jamf.com/jamf-pro/reference/delete_v1-computers-inventory-id
+func (j *Client) DeleteComputerInventory(id string) error {
+	return j.DeleteComputerInventoryContext(context.Background(), id)
+}
+
+// DeleteComputerInventoryContext is like DeleteComputerInventory but uses the given context for the request
+func (j *Client) DeleteComputerInventoryContext(ctx context.Context, id string) error {
+	if err := j.makeAPIrequest(ctx, "DELETE", resourcePath(computersInventoryContext, id), nil, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF delete request for computer inventory with ID: %s", id)
+	}
+	return nil
+}
+
+// ComputerDetails maps the inventory onto the classic API representation of a computer so code written
+// against classic.ComputerDetails can consume it. Only the fields available in the requested sections are
+// set, the department and building are left empty since the Jamf Pro API only returns their IDs
+func (c *ComputerInventory) ComputerDetails() *classic.ComputerDetails {
+	id, _ := strconv.Atoi(c.ID)
+	d := &classic.ComputerDetails{ID: id}
+	d.General.ID = id
+	d.General.UDID = c.UDID
+
+	if g := c.General; g != nil {
+		d.General.Name = g.Name
+		d.General.JamfVersion = g.JamfBinaryVersion
+		d.General.Platform = g.Platform
+		d.General.MDMCapable = g.MDMCapable != nil && g.MDMCapable.Capable
+		d.General.ReportDate = g.ReportDate
+		d.General.IPAddress = g.LastIPAddress
+		d.General.LastReportedIP = g.LastReportedIP
+		d.General.LastEnrolledDateUTC = g.LastEnrolledDate
+		d.ExtensionAttributes = append(d.ExtensionAttributes, classicExtensionAttributes(g.ExtensionAttributes)...)
+	}
+
+	if h := c.Hardware; h != nil {
+		d.General.MACAddress = h.MACAddress
+		d.General.SerialNumber = h.SerialNumber
+		d.Hardware.Make = h.Make
+	}
+
+	if u := c.UserAndLocation; u != nil {
+		d.UserLocation.Username = u.Username
+		d.UserLocation.RealName = u.RealName
+		d.UserLocation.EmailAddress = u.Email
+		d.UserLocation.Position = u.Position
+	}
+
+	if os := c.OperatingSystem; os != nil {
+		d.Hardware.OSName = os.Name
+		d.Hardware.OSVersion = os.Version
+		d.Hardware.OSBuild = os.Build
+	}
+
+	if s := c.Security; s != nil {
+		d.Hardware.SIPStatus = s.SIPStatus
+		d.Hardware.GatekeeperStatus = s.GatekeeperStatus
+		d.Hardware.XProtectVersion = s.XProtectVersion
+	}
+
+	if e := c.DiskEncryption; e != nil {
+		d.Hardware.FilevaultUsers = e.FileVault2EnabledUserNames
+	}
+
+	for _, cert := range c.Certificates {
+		d.Certificates = append(d.Certificates, classic.CertificateInformation{
+			CommonName: cert.CommonName,
+			Identity:   cert.Identity,
+			ExpiresUTC: cert.ExpirationDate,
+			Name:       cert.SubjectName,
+		})
+	}
+
+	for _, app := range c.Applications {
+		d.Software.Applications = append(d.Software.Applications, classic.ApplicationInformation{
+			Name:    app.Name,
+			Path:    app.Path,
+			Version: app.Version,
+		})
+	}
+
+	for _, group := range c.GroupMemberships {
+		d.Groups.Memberships = append(d.Groups.Memberships, group.GroupName)
+	}
+
+	// the local accounts element type is an anonymous struct so the slice is grown rather than appended to
+	d.Groups.LocalAccounts = slices.Grow(d.Groups.LocalAccounts, len(c.LocalUserAccounts))[:len(c.LocalUserAccounts)]
+	for i, account := range c.LocalUserAccounts {
+		d.Groups.LocalAccounts[i].Name = account.Username
+		d.Groups.LocalAccounts[i].RealName = account.FullName
+		d.Groups.LocalAccounts[i].UID = account.UID
+		d.Groups.LocalAccounts[i].Administrator = account.Admin
+		d.Groups.LocalAccounts[i].FilevalutEnabled = account.FileVault2Enabled
+	}
+
+	for _, profile := range c.ConfigurationProfiles {
+		profileID, _ := strconv.Atoi(profile.ID)
+		d.ConfigProfiles = append(d.ConfigProfiles, classic.ConfigProfile{
+			ID:        profileID,
+			Name:      profile.DisplayName,
+			Removable: profile.Removable,
+		})
+	}
+
+	d.ExtensionAttributes = append(d.ExtensionAttributes, classicExtensionAttributes(c.ExtensionAttributes)...)
+	return d
+}
+
+// classicDataTypes maps the data types of the Jamf Pro API onto the ones used by the classic API
+var classicDataTypes = map[string]string{
+	"STRING":  "String",
+	"INTEGER": "Number",
+	"DATE":    "Date",
+}
+
+// classicExtensionAttributes maps extension attributes onto the classic API representation,
+// multiple values are joined with a comma
+func classicExtensionAttributes(attrs []ExtensionAttribute) []classic.ExtensionAttribute {
+	res := []classic.ExtensionAttribute{}
+	for _, ea := range attrs {
+		id, _ := strconv.Atoi(ea.DefinitionID)
+		dataType, ok := classicDataTypes[ea.DataType]
+		if !ok {
+			dataType = ea.DataType
+		}
+		res = append(res, classic.ExtensionAttribute{
+			ID:    id,
+			Name:  ea.Name,
+			Type:  dataType,
+			Value: strings.Join(ea.Values, ","),
+		})
+	}
+	return res
+}
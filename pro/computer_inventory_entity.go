@@ -0,0 +1,260 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package pro
+
+// ComputerInventorySection is a section of a computer's inventory which can be requested
+type ComputerInventorySection string
+
+// Sections of a computer's inventory, GENERAL is returned when no sections are requested
+const (
+	SectionGeneral               ComputerInventorySection = "GENERAL"
+	SectionDiskEncryption        ComputerInventorySection = "DISK_ENCRYPTION"
+	SectionPurchasing            ComputerInventorySection = "PURCHASING"
+	SectionApplications          ComputerInventorySection = "APPLICATIONS"
+	SectionStorage               ComputerInventorySection = "STORAGE"
+	SectionUserAndLocation       ComputerInventorySection = "USER_AND_LOCATION"
+	SectionConfigurationProfiles ComputerInventorySection = "CONFIGURATION_PROFILES"
+	SectionPrinters              ComputerInventorySection = "PRINTERS"
+	SectionServices              ComputerInventorySection = "SERVICES"
+	SectionHardware              ComputerInventorySection = "HARDWARE"
+	SectionLocalUserAccounts     ComputerInventorySection = "LOCAL_USER_ACCOUNTS"
+	SectionCertificates          ComputerInventorySection = "CERTIFICATES"
+	SectionAttachments           ComputerInventorySection = "ATTACHMENTS"
+	SectionPlugins               ComputerInventorySection = "PLUGINS"
+	SectionPackageReceipts       ComputerInventorySection = "PACKAGE_RECEIPTS"
+	SectionFonts                 ComputerInventorySection = "FONTS"
+	SectionSecurity              ComputerInventorySection = "SECURITY"
+	SectionOperatingSystem       ComputerInventorySection = "OPERATING_SYSTEM"
+	SectionLicensedSoftware      ComputerInventorySection = "LICENSED_SOFTWARE"
+	SectionIBeacons              ComputerInventorySection = "IBEACONS"
+	SectionSoftwareUpdates       ComputerInventorySection = "SOFTWARE_UPDATES"
+	SectionExtensionAttributes   ComputerInventorySection = "EXTENSION_ATTRIBUTES"
+	SectionContentCaching        ComputerInventorySection = "CONTENT_CACHING"
+	SectionGroupMemberships      ComputerInventorySection = "GROUP_MEMBERSHIPS"
+)
+
+// ComputerInventoryOptions are the parameters accepted by the computers inventory list endpoint
+type ComputerInventoryOptions struct {
+	ListOptions
+	Sections []ComputerInventorySection
+}
+
+// ComputerInventory represents a computer returned by the computers inventory endpoints.
+// Only the sections requested are populated
+type ComputerInventory struct {
+	ID                    string                         `json:"id"`
+	UDID                  string                         `json:"udid,omitempty"`
+	General               *ComputerInventoryGeneral      `json:"general,omitempty"`
+	DiskEncryption        *ComputerDiskEncryption        `json:"diskEncryption,omitempty"`
+	UserAndLocation       *ComputerUserAndLocation       `json:"userAndLocation,omitempty"`
+	Hardware              *ComputerHardware              `json:"hardware,omitempty"`
+	OperatingSystem       *ComputerOperatingSystem       `json:"operatingSystem,omitempty"`
+	Security              *ComputerSecurity              `json:"security,omitempty"`
+	Applications          []ComputerApplication          `json:"applications,omitempty"`
+	Certificates          []ComputerCertificate          `json:"certificates,omitempty"`
+	ConfigurationProfiles []ComputerConfigurationProfile `json:"configurationProfiles,omitempty"`
+	LocalUserAccounts     []ComputerLocalUserAccount     `json:"localUserAccounts,omitempty"`
+	GroupMemberships      []ComputerGroupMembership      `json:"groupMemberships,omitempty"`
+	ExtensionAttributes   []ExtensionAttribute           `json:"extensionAttributes,omitempty"`
+}
+
+// ComputerInventoryGeneral holds the GENERAL section of a computer's inventory
+type ComputerInventoryGeneral struct {
+	Name                                 string               `json:"name,omitempty"`
+	LastIPAddress                        string               `json:"lastIpAddress,omitempty"`
+	LastReportedIP                       string               `json:"lastReportedIp,omitempty"`
+	JamfBinaryVersion                    string               `json:"jamfBinaryVersion,omitempty"`
+	Platform                             string               `json:"platform,omitempty"`
+	Barcode1                             string               `json:"barcode1,omitempty"`
+	Barcode2                             string               `json:"barcode2,omitempty"`
+	AssetTag                             string               `json:"assetTag,omitempty"`
+	RemoteManagement                     *RemoteManagement    `json:"remoteManagement,omitempty"`
+	Supervised                           bool                 `json:"supervised,omitempty"`
+	MDMCapable                           *MDMCapable          `json:"mdmCapable,omitempty"`
+	ReportDate                           string               `json:"reportDate,omitempty"`
+	LastContactTime                      string               `json:"lastContactTime,omitempty"`
+	LastCloudBackupDate                  string               `json:"lastCloudBackupDate,omitempty"`
+	LastEnrolledDate                     string               `json:"lastEnrolledDate,omitempty"`
+	MDMProfileExpiration                 string               `json:"mdmProfileExpiration,omitempty"`
+	InitialEntryDate                     string               `json:"initialEntryDate,omitempty"`
+	DistributionPoint                    string               `json:"distributionPoint,omitempty"`
+	Site                                 *Site                `json:"site,omitempty"`
+	ITunesStoreAccountActive             bool                 `json:"itunesStoreAccountActive,omitempty"`
+	EnterpriseManaged                    bool                 `json:"enterpriseManaged,omitempty"`
+	EnrolledViaAutomatedDeviceEnrollment bool                 `json:"enrolledViaAutomatedDeviceEnrollment,omitempty"`
+	UserApprovedMDM                      bool                 `json:"userApprovedMdm,omitempty"`
+	DeclarativeDeviceManagementEnabled   bool                 `json:"declarativeDeviceManagementEnabled,omitempty"`
+	ExtensionAttributes                  []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+	ManagementID                         string               `json:"managementId,omitempty"`
+}
+
+// RemoteManagement holds the management account of a computer
+type RemoteManagement struct {
+	Managed            bool   `json:"managed"`
+	ManagementUsername string `json:"managementUsername,omitempty"`
+}
+
+// MDMCapable holds whether a computer and its users are capable of MDM
+type MDMCapable struct {
+	Capable      bool     `json:"capable"`
+	CapableUsers []string `json:"capableUsers,omitempty"`
+}
+
+// Site represents the site an object is assigned to
+type Site struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// ExtensionAttribute represents an extension attribute value of a computer or mobile device
+type ExtensionAttribute struct {
+	DefinitionID string   `json:"definitionId"`
+	Name         string   `json:"name,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Enabled      bool     `json:"enabled,omitempty"`
+	MultiValue   bool     `json:"multiValue,omitempty"`
+	Values       []string `json:"values"`
+	DataType     string   `json:"dataType,omitempty"`
+	Options      []string `json:"options,omitempty"`
+	InputType    string   `json:"inputType,omitempty"`
+}
+
+// ComputerDiskEncryption holds the DISK_ENCRYPTION section of a computer's inventory
+type ComputerDiskEncryption struct {
+	IndividualRecoveryKeyValidityStatus string   `json:"individualRecoveryKeyValidityStatus,omitempty"`
+	InstitutionalRecoveryKeyPresent     bool     `json:"institutionalRecoveryKeyPresent,omitempty"`
+	DiskEncryptionConfigurationName     string   `json:"diskEncryptionConfigurationName,omitempty"`
+	FileVault2EnabledUserNames          []string `json:"fileVault2EnabledUserNames,omitempty"`
+	FileVault2EligibilityMessage        string   `json:"fileVault2EligibilityMessage,omitempty"`
+}
+
+// ComputerUserAndLocation holds the USER_AND_LOCATION section of a computer's inventory
+type ComputerUserAndLocation struct {
+	Username     string `json:"username,omitempty"`
+	RealName     string `json:"realname,omitempty"`
+	Email        string `json:"email,omitempty"`
+	Position     string `json:"position,omitempty"`
+	Phone        string `json:"phone,omitempty"`
+	DepartmentID string `json:"departmentId,omitempty"`
+	BuildingID   string `json:"buildingId,omitempty"`
+	Room         string `json:"room,omitempty"`
+}
+
+// ComputerHardware holds the HARDWARE section of a computer's inventory
+type ComputerHardware struct {
+	Make                   string `json:"make,omitempty"`
+	Model                  string `json:"model,omitempty"`
+	ModelIdentifier        string `json:"modelIdentifier,omitempty"`
+	SerialNumber           string `json:"serialNumber,omitempty"`
+	ProcessorSpeedMhz      int    `json:"processorSpeedMhz,omitempty"`
+	ProcessorCount         int    `json:"processorCount,omitempty"`
+	CoreCount              int    `json:"coreCount,omitempty"`
+	ProcessorType          string `json:"processorType,omitempty"`
+	ProcessorArchitecture  string `json:"processorArchitecture,omitempty"`
+	TotalRAMMegabytes      int    `json:"totalRamMegabytes,omitempty"`
+	MACAddress             string `json:"macAddress,omitempty"`
+	AltMACAddress          string `json:"altMacAddress,omitempty"`
+	BatteryCapacityPercent int    `json:"batteryCapacityPercent,omitempty"`
+	AppleSilicon           bool   `json:"appleSilicon,omitempty"`
+}
+
+// ComputerOperatingSystem holds the OPERATING_SYSTEM section of a computer's inventory
+type ComputerOperatingSystem struct {
+	Name                     string `json:"name,omitempty"`
+	Version                  string `json:"version,omitempty"`
+	Build                    string `json:"build,omitempty"`
+	SupplementalBuildVersion string `json:"supplementalBuildVersion,omitempty"`
+	RapidSecurityResponse    string `json:"rapidSecurityResponse,omitempty"`
+	ActiveDirectoryStatus    string `json:"activeDirectoryStatus,omitempty"`
+	FileVault2Status         string `json:"fileVault2Status,omitempty"`
+	SoftwareUpdateDeviceID   string `json:"softwareUpdateDeviceId,omitempty"`
+}
+
+// ComputerSecurity holds the SECURITY section of a computer's inventory
+type ComputerSecurity struct {
+	SIPStatus             string `json:"sipStatus,omitempty"`
+	GatekeeperStatus      string `json:"gatekeeperStatus,omitempty"`
+	XProtectVersion       string `json:"xprotectVersion,omitempty"`
+	AutoLoginDisabled     bool   `json:"autoLoginDisabled,omitempty"`
+	RemoteDesktopEnabled  bool   `json:"remoteDesktopEnabled,omitempty"`
+	ActivationLockEnabled bool   `json:"activationLockEnabled,omitempty"`
+	RecoveryLockEnabled   bool   `json:"recoveryLockEnabled,omitempty"`
+	FirewallEnabled       bool   `json:"firewallEnabled,omitempty"`
+	SecureBootLevel       string `json:"secureBootLevel,omitempty"`
+	ExternalBootLevel     string `json:"externalBootLevel,omitempty"`
+	BootstrapTokenAllowed bool   `json:"bootstrapTokenAllowed,omitempty"`
+}
+
+// ComputerApplication holds an application from the APPLICATIONS section of a computer's inventory
+type ComputerApplication struct {
+	Name              string `json:"name"`
+	Path              string `json:"path,omitempty"`
+	Version           string `json:"version,omitempty"`
+	MacAppStore       bool   `json:"macAppStore,omitempty"`
+	SizeMegabytes     int    `json:"sizeMegabytes,omitempty"`
+	BundleID          string `json:"bundleId,omitempty"`
+	UpdateAvailable   bool   `json:"updateAvailable,omitempty"`
+	ExternalVersionID string `json:"externalVersionId,omitempty"`
+}
+
+// ComputerCertificate holds a certificate from the CERTIFICATES section of a computer's inventory
+type ComputerCertificate struct {
+	CommonName        string `json:"commonName,omitempty"`
+	Identity          bool   `json:"identity,omitempty"`
+	ExpirationDate    string `json:"expirationDate,omitempty"`
+	Username          string `json:"username,omitempty"`
+	LifecycleStatus   string `json:"lifecycleStatus,omitempty"`
+	CertificateStatus string `json:"certificateStatus,omitempty"`
+	SubjectName       string `json:"subjectName,omitempty"`
+	SerialNumber      string `json:"serialNumber,omitempty"`
+	SHA1Fingerprint   string `json:"sha1Fingerprint,omitempty"`
+	IssuedDate        string `json:"issuedDate,omitempty"`
+}
+
+// ComputerConfigurationProfile holds a profile from the CONFIGURATION_PROFILES section of a computer's inventory
+type ComputerConfigurationProfile struct {
+	ID                string `json:"id,omitempty"`
+	Username          string `json:"username,omitempty"`
+	LastInstalled     string `json:"lastInstalled,omitempty"`
+	Removable         bool   `json:"removable,omitempty"`
+	DisplayName       string `json:"displayName,omitempty"`
+	ProfileIdentifier string `json:"profileIdentifier,omitempty"`
+}
+
+// ComputerLocalUserAccount holds an account from the LOCAL_USER_ACCOUNTS section of a computer's inventory
+type ComputerLocalUserAccount struct {
+	UID                    string `json:"uid,omitempty"`
+	Username               string `json:"username,omitempty"`
+	FullName               string `json:"fullName,omitempty"`
+	Admin                  bool   `json:"admin,omitempty"`
+	HomeDirectory          string `json:"homeDirectory,omitempty"`
+	FileVault2Enabled      bool   `json:"fileVault2Enabled,omitempty"`
+	UserAccountType        string `json:"userAccountType,omitempty"`
+	PasswordHistoryDepth   string `json:"passwordHistoryDepth,omitempty"`
+	AzureActiveDirectoryID string `json:"azureActiveDirectoryId,omitempty"`
+}
+
+// ComputerGroupMembership holds a group from the GROUP_MEMBERSHIPS section of a computer's inventory
+type ComputerGroupMembership struct {
+	GroupID    string `json:"groupId,omitempty"`
+	GroupName  string `json:"groupName,omitempty"`
+	SmartGroup bool   `json:"smartGroup,omitempty"`
+}
+
+// ComputerInventoryUpdate represents the fields that can be updated on a computer's inventory
+type ComputerInventoryUpdate struct {
+	UDID    string                          `json:"udid,omitempty"`
+	General *ComputerInventoryGeneralUpdate `json:"general,omitempty"`
+}
+
+// ComputerInventoryGeneralUpdate represents the GENERAL section fields that can be updated
+type ComputerInventoryGeneralUpdate struct {
+	Name                string               `json:"name,omitempty"`
+	LastIPAddress       string               `json:"lastIpAddress,omitempty"`
+	Barcode1            string               `json:"barcode1,omitempty"`
+	Barcode2            string               `json:"barcode2,omitempty"`
+	AssetTag            string               `json:"assetTag,omitempty"`
+	SiteID              string               `json:"siteId,omitempty"`
+	ExtensionAttributes []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
@@ -0,0 +1,271 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package pro_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var COMPUTERS_INVENTORY_API_ENDPOINT = "/api/v1/computers-inventory"
+
+func computersInventoryResponseMocks(t *testing.T) *httptest.Server {
+	return authenticated(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case COMPUTERS_INVENTORY_API_ENDPOINT:
+			query := r.URL.Query()
+			if len(query["section"]) > 0 {
+				computerInventoryPages(t, w, r)
+				return
+			}
+			assert.Equal(t, `general.name==Mac*;general.platform==Mac`, query.Get("filter"))
+			assert.Equal(t, []string{"general.name:asc", "id:desc"}, query["sort"])
+			assert.Equal(t, "50", query.Get("page-size"))
+			fmt.Fprint(w, `{
+				"totalCount": 1,
+				"results": [{
+					"id": "1",
+					"udid": "123",
+					"general": {
+						"name": "Mac Test 1",
+						"lastIpAddress": "10.0.0.1",
+						"platform": "Mac",
+						"remoteManagement": {"managed": true, "managementUsername": "rootname"},
+						"mdmCapable": {"capable": true, "capableUsers": ["admin"]},
+						"site": {"id": "-1", "name": "None"},
+						"extensionAttributes": [{"definitionId": "23", "name": "Owner", "values": ["jane"], "dataType": "STRING"}]
+					}
+				}]
+			}`)
+		case fmt.Sprintf("%s/1", COMPUTERS_INVENTORY_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				if r.URL.Query().Get("section") == "HARDWARE" {
+					fmt.Fprint(w, `{"id": "1", "udid": "123", "hardware": {"make": "Apple", "serialNumber": "C02XXXX"}}`)
+					return
+				}
+				fmt.Fprint(w, `{"id": "1", "udid": "123", "general": {"name": "Mac Test 1"}}`)
+			case "DELETE":
+				w.WriteHeader(http.StatusNoContent)
+			}
+		case "/api/v1/computers-inventory-detail/1":
+			assert.Equal(t, "PATCH", r.Method)
+			update := &pro.ComputerInventoryUpdate{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(update))
+			fmt.Fprintf(w, `{"id": "1", "general": {"name": "%s", "assetTag": "%s"}}`, update.General.Name, update.General.AssetTag)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	})
+}
+
+func TestComputersInventory(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	opts := &pro.ComputerInventoryOptions{
+		ListOptions: pro.ListOptions{
+			PageSize: 50,
+			Sort:     []pro.Sort{pro.Asc("general.name"), pro.Desc("id")},
+			Filter:   pro.And(pro.Eq("general.name", "Mac*"), pro.Eq("general.platform", "Mac")),
+		},
+	}
+	page, err := j.ComputersInventory(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.TotalCount)
+	computer := page.Results[0]
+	assert.Equal(t, "1", computer.ID)
+	assert.Equal(t, "Mac Test 1", computer.General.Name)
+	assert.True(t, computer.General.RemoteManagement.Managed)
+	assert.Equal(t, []string{"admin"}, computer.General.MDMCapable.CapableUsers)
+	assert.Equal(t, "None", computer.General.Site.Name)
+	assert.Equal(t, []string{"jane"}, computer.General.ExtensionAttributes[0].Values)
+
+	all, err := j.AllComputersInventory(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(all))
+}
+
+func TestComputerInventoryDetails(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	computer, err := j.ComputerInventoryDetails("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "123", computer.UDID)
+
+	computer, err = j.ComputerInventoryDetails("1", pro.SectionHardware)
+	assert.Nil(t, err)
+	assert.Nil(t, computer.General)
+	assert.Equal(t, "C02XXXX", computer.Hardware.SerialNumber)
+
+	updated, err := j.UpdateComputerInventory("1", &pro.ComputerInventoryUpdate{
+		General: &pro.ComputerInventoryGeneralUpdate{Name: "Renamed Mac", AssetTag: "A1"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Renamed Mac", updated.General.Name)
+	assert.Equal(t, "A1", updated.General.AssetTag)
+
+	assert.Nil(t, j.DeleteComputerInventory("1"))
+}
+
+// computerInventoryPages serves 5 computers with every section over pages of 2
+func computerInventoryPages(t *testing.T, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	assert.Equal(t, []string{"GENERAL", "HARDWARE", "APPLICATIONS", "EXTENSION_ATTRIBUTES"}, query["section"])
+	assert.Equal(t, "general.platform==Mac", query.Get("filter"))
+	assert.Equal(t, "2", query.Get("page-size"))
+
+	page, err := strconv.Atoi(query.Get("page"))
+	assert.Nil(t, err)
+	results := []string{}
+	for id := page*2 + 1; id <= 5 && id <= page*2+2; id++ {
+		results = append(results, fmt.Sprintf(`{
+			"id": "%d",
+			"udid": "UDID-%d",
+			"general": {"name": "Mac %d", "platform": "Mac", "lastIpAddress": "10.0.0.%d", "jamfBinaryVersion": "11.4.1", "mdmCapable": {"capable": true}},
+			"hardware": {"make": "Apple", "serialNumber": "C02-%d", "macAddress": "aa:bb:cc:dd:ee:0%d"},
+			"applications": [{"name": "Safari.app", "path": "/Applications/Safari.app", "version": "17.4"}],
+			"extensionAttributes": [{"definitionId": "7", "name": "Owner", "dataType": "STRING", "values": ["jane", "john"]}]
+		}`, id, id, id, id, id, id))
+	}
+	fmt.Fprintf(w, `{"totalCount": 5, "results": [%s]}`, strings.Join(results, ","))
+}
+
+func TestIterComputersInventory(t *testing.T) {
+	var requests int32
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	counting := func(next classic.Doer) classic.Doer {
+		return classic.DoerFunc(func(r *http.Request) (*http.Response, error) {
+			if r.URL.Path == COMPUTERS_INVENTORY_API_ENDPOINT {
+				atomic.AddInt32(&requests, 1)
+			}
+			return next.Do(r)
+		})
+	}
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil, classic.WithMiddleware(counting))
+	assert.Nil(t, err)
+
+	opts := &pro.ComputerInventoryOptions{
+		ListOptions: pro.ListOptions{PageSize: 2, Filter: pro.Eq("general.platform", "Mac")},
+		Sections:    []pro.ComputerInventorySection{pro.SectionGeneral, pro.SectionHardware, pro.SectionApplications, pro.SectionExtensionAttributes},
+	}
+
+	// pages are only fetched once the previous page is consumed
+	names := []string{}
+	for computer, err := range j.IterComputersInventory(opts) {
+		assert.Nil(t, err)
+		names = append(names, computer.General.Name)
+		if len(names) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []string{"Mac 1", "Mac 2", "Mac 3"}, names)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	atomic.StoreInt32(&requests, 0)
+	computers := []pro.ComputerInventory{}
+	for computer, err := range j.IterComputersInventory(opts) {
+		assert.Nil(t, err)
+		computers = append(computers, computer)
+	}
+	assert.Equal(t, 5, len(computers))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	all, err := j.AllComputersInventory(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, computers, all)
+
+	details := computers[4].ComputerDetails()
+	assert.Equal(t, 5, details.ID)
+	assert.Equal(t, 5, details.General.ID)
+	assert.Equal(t, "Mac 5", details.General.Name)
+	assert.Equal(t, "UDID-5", details.General.UDID)
+	assert.Equal(t, "C02-5", details.General.SerialNumber)
+	assert.Equal(t, "aa:bb:cc:dd:ee:05", details.General.MACAddress)
+	assert.Equal(t, "10.0.0.5", details.General.IPAddress)
+	assert.Equal(t, "11.4.1", details.General.JamfVersion)
+	assert.True(t, details.General.MDMCapable)
+	assert.Equal(t, "Apple", details.Hardware.Make)
+	assert.Equal(t, []classic.ApplicationInformation{{Name: "Safari.app", Path: "/Applications/Safari.app", Version: "17.4"}}, details.Software.Applications)
+	assert.Equal(t, []classic.ExtensionAttribute{{ID: 7, Name: "Owner", Type: "String", Value: "jane,john"}}, details.ExtensionAttributes)
+}
+
+func TestIterComputersInventoryCappedPageSize(t *testing.T) {
+	var requests int32
+	// the server returns at most 2 computers per page whatever the requested page size
+	testServer := authenticated(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		assert.Equal(t, strconv.Itoa(pro.MaxPageSize), r.URL.Query().Get("page-size"))
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		assert.Nil(t, err)
+		results := []string{}
+		for id := page*2 + 1; id <= 5 && id <= page*2+2; id++ {
+			results = append(results, fmt.Sprintf(`{"id": "%d", "general": {"name": "Mac %d"}}`, id, id))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"totalCount": 5, "results": [%s]}`, strings.Join(results, ","))
+	})
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	computers, err := j.AllComputersInventory(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(computers))
+	assert.Equal(t, "Mac 5", computers[4].General.Name)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestIterComputersInventoryLastPage(t *testing.T) {
+	var requests int32
+	testServer := authenticated(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		assert.Equal(t, "3", r.URL.Query().Get("page-size"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"totalCount": 5, "results": [{"id": "4", "general": {"name": "Mac 4"}}, {"id": "5", "general": {"name": "Mac 5"}}]}`)
+	})
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	// a short page starting part way through the results is the last one
+	computers, err := j.AllComputersInventory(&pro.ComputerInventoryOptions{ListOptions: pro.ListOptions{Page: 1, PageSize: 3}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(computers))
+	assert.Equal(t, "Mac 5", computers[1].General.Name)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestIterComputersInventoryError(t *testing.T) {
+	testServer := authenticated(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	errs := 0
+	for _, err := range j.IterComputersInventory(nil) {
+		assert.NotNil(t, err)
+		errs++
+	}
+	assert.Equal(t, 1, errs)
+}
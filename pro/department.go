@@ -0,0 +1,103 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package pro
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// Departments returns a single page of departments
+// https://developer.jamf.com/jamf-pro/reference/get_v1-departments
+func (j *Client) Departments(opts *ListOptions) (*Page[Department], error) {
+	return j.DepartmentsContext(context.Background(), opts)
+}
+
+// DepartmentsContext is like Departments but uses the given context for the request
+func (j *Client) DepartmentsContext(ctx context.Context, opts *ListOptions) (*Page[Department], error) {
+	res, err := listPage[Department](ctx, j, departmentsContext, opts.values())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to query departments")
+	}
+	return res, nil
+}
+
+// AllDepartments returns every department matching the options
+func (j *Client) AllDepartments(opts *ListOptions) ([]Department, error) {
+	return j.AllDepartmentsContext(context.Background(), opts)
+}
+
+// AllDepartmentsContext is like AllDepartments but uses the given context for the requests
+func (j *Client) AllDepartmentsContext(ctx context.Context, opts *ListOptions) ([]Department, error) {
+	res, err := listAll[Department](ctx, j, departmentsContext, opts, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to query departments")
+	}
+	return res, nil
+}
+
+// DepartmentDetails returns a department given its ID
+// https://developer.jamf.com/jamf-pro/reference/get_v1-departments-id
+func (j *Client) DepartmentDetails(id string) (*Department, error) {
+	return j.DepartmentDetailsContext(context.Background(), id)
+}
+
+// DepartmentDetailsContext is like DepartmentDetails but uses the given context for the request
+func (j *Client) DepartmentDetailsContext(ctx context.Context, id string) (*Department, error) {
+	res := Department{}
+	if err := j.makeAPIrequest(ctx, "GET", resourcePath(departmentsContext, id), nil, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query department with ID: %s", id)
+	}
+	return &res, nil
+}
+
+// CreateDepartment creates a department with the given name
+// https://developer.jamf.com/jamf-pro/reference/post_v1-departments
+func (j *Client) CreateDepartment(name string) (*HrefResponse, error) {
+	return j.CreateDepartmentContext(context.Background(), name)
+}
+
+// CreateDepartmentContext is like CreateDepartment but uses the given context for the request
+func (j *Client) CreateDepartmentContext(ctx context.Context, name string) (*HrefResponse, error) {
+	if name == "" {
+		return nil, errors.Wrap(fmt.Errorf("name required for new department"), "unable to process JAMF creation request for department")
+	}
+
+	res := HrefResponse{}
+	if err := j.makeAPIrequest(ctx, "POST", departmentsContext, nil, &Department{Name: name}, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for department: %s", name)
+	}
+	return &res, nil
+}
+
+// UpdateDepartment renames a department given its ID
+// https://developer.jamf.com/jamf-pro/reference/put_v1-departments-id
+func (j *Client) UpdateDepartment(id string, name string) (*Department, error) {
+	return j.UpdateDepartmentContext(context.Background(), id, name)
+}
+
+// UpdateDepartmentContext is like UpdateDepartment but uses the given context for the request
+func (j *Client) UpdateDepartmentContext(ctx context.Context, id string, name string) (*Department, error) {
+	res := Department{}
+	if err := j.makeAPIrequest(ctx, "PUT", resourcePath(departmentsContext, id), nil, &Department{Name: name}, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for department with ID: %s", id)
+	}
+	return &res, nil
+}
+
+// DeleteDepartment deletes a department given its ID
+// https://developer.jamf.com/jamf-pro/reference/delete_v1-departments-id
+func (j *Client) DeleteDepartment(id string) error {
+	return j.DeleteDepartmentContext(context.Background(), id)
+}
+
+// DeleteDepartmentContext is like DeleteDepartment but uses the given context for the request
+func (j *Client) DeleteDepartmentContext(ctx context.Context, id string) error {
+	if err := j.makeAPIrequest(ctx, "DELETE", resourcePath(departmentsContext, id), nil, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF delete request for department with ID: %s", id)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package pro
+
+// Department represents a department in Jamf
+type Department struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// HrefResponse is returned when a resource is created
+type HrefResponse struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
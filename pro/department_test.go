@@ -0,0 +1,96 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package pro_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var DEPARTMENTS_API_ENDPOINT = "/api/v1/departments"
+
+func departmentsResponseMocks(t *testing.T) *httptest.Server {
+	return authenticated(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case DEPARTMENTS_API_ENDPOINT:
+			switch r.Method {
+			case "GET":
+				switch r.URL.Query().Get("page") {
+				case "", "0":
+					fmt.Fprint(w, `{"totalCount": 3, "results": [{"id": "1", "name": "Engineering"}, {"id": "2", "name": "Finance"}]}`)
+				case "1":
+					fmt.Fprint(w, `{"totalCount": 3, "results": [{"id": "3", "name": "Sales"}]}`)
+				}
+			case "POST":
+				department := &pro.Department{}
+				assert.Nil(t, json.NewDecoder(r.Body).Decode(department))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintf(w, `{"id": "4", "href": "%s/4"}`, DEPARTMENTS_API_ENDPOINT)
+			}
+		case fmt.Sprintf("%s/1", DEPARTMENTS_API_ENDPOINT):
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{"id": "1", "name": "Engineering"}`)
+			case "PUT":
+				department := &pro.Department{}
+				assert.Nil(t, json.NewDecoder(r.Body).Decode(department))
+				fmt.Fprintf(w, `{"id": "1", "name": "%s"}`, department.Name)
+			case "DELETE":
+				w.WriteHeader(http.StatusNoContent)
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	})
+}
+
+func TestDepartments(t *testing.T) {
+	testServer := departmentsResponseMocks(t)
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	page, err := j.Departments(&pro.ListOptions{PageSize: 2, Sort: []pro.Sort{pro.Asc("name")}})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, page.TotalCount)
+	assert.Equal(t, 2, len(page.Results))
+	assert.Equal(t, "Engineering", page.Results[0].Name)
+
+	departments, err := j.AllDepartments(&pro.ListOptions{PageSize: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(departments))
+	assert.Equal(t, "Sales", departments[2].Name)
+}
+
+func TestDepartmentCRUD(t *testing.T) {
+	testServer := departmentsResponseMocks(t)
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	created, err := j.CreateDepartment("Marketing")
+	assert.Nil(t, err)
+	assert.Equal(t, "4", created.ID)
+	assert.Equal(t, "/api/v1/departments/4", created.Href)
+
+	_, err = j.CreateDepartment("")
+	assert.NotNil(t, err)
+
+	department, err := j.DepartmentDetails("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Engineering", department.Name)
+
+	updated, err := j.UpdateDepartment("1", "Platform Engineering")
+	assert.Nil(t, err)
+	assert.Equal(t, "Platform Engineering", updated.Name)
+
+	assert.Nil(t, j.DeleteDepartment("1"))
+}
@@ -0,0 +1,69 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package pro
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// MobileDevices returns a single page of mobile devices
+// https://developer.jamf.com/jamf-pro/reference/get_v2-mobile-devices
+func (j *Client) MobileDevices(opts *ListOptions) (*Page[MobileDevice], error) {
+	return j.MobileDevicesContext(context.Background(), opts)
+}
+
+// MobileDevicesContext is like MobileDevices but uses the given context for the request
+func (j *Client) MobileDevicesContext(ctx context.Context, opts *ListOptions) (*Page[MobileDevice], error) {
+	res, err := listPage[MobileDevice](ctx, j, mobileDevicesContext, opts.values())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to query mobile devices")
+	}
+	return res, nil
+}
+
+// AllMobileDevices returns every mobile device matching the options
+func (j *Client) AllMobileDevices(opts *ListOptions) ([]MobileDevice, error) {
+	return j.AllMobileDevicesContext(context.Background(), opts)
+}
+
+// AllMobileDevicesContext is like AllMobileDevices but uses the given context for the requests
+func (j *Client) AllMobileDevicesContext(ctx context.Context, opts *ListOptions) ([]MobileDevice, error) {
+	res, err := listAll[MobileDevice](ctx, j, mobileDevicesContext, opts, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to query mobile devices")
+	}
+	return res, nil
+}
+
+// MobileDeviceDetails returns the details of a mobile device given its ID
+// https://developer.jamf.com/jamf-pro/reference/get_v2-mobile-devices-id-detail
+func (j *Client) MobileDeviceDetails(id string) (*MobileDeviceDetails, error) {
+	return j.MobileDeviceDetailsContext(context.Background(), id)
+}
+
+// MobileDeviceDetailsContext is like MobileDeviceDetails but uses the given context for the request
+func (j *Client) MobileDeviceDetailsContext(ctx context.Context, id string) (*MobileDeviceDetails, error) {
+	res := MobileDeviceDetails{}
+	if err := j.makeAPIrequest(ctx, "GET", resourcePath(mobileDevicesContext, id, "detail"), nil, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile device with ID: %s", id)
+	}
+	return &res, nil
+}
+
+// UpdateMobileDevice updates the fields of a mobile device given its ID
+// https://developer.jamf.com/jamf-pro/reference/patch_v2-mobile-devices-id
+func (j *Client) UpdateMobileDevice(id string, updates *MobileDeviceUpdate) (*MobileDeviceDetails, error) {
+	return j.UpdateMobileDeviceContext(context.Background(), id, updates)
+}
+
+// UpdateMobileDeviceContext is like UpdateMobileDevice but uses the given context for the request
+func (j *Client) UpdateMobileDeviceContext(ctx context.Context, id string, updates *MobileDeviceUpdate) (*MobileDeviceDetails, error) {
+	res := MobileDeviceDetails{}
+	if err := j.makeAPIrequest(ctx, "PATCH", resourcePath(mobileDevicesContext, id), nil, updates, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for mobile device with ID: %s", id)
+	}
+	return &res, nil
+}
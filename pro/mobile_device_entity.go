@@ -0,0 +1,95 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package pro
+
+// MobileDevice represents a mobile device returned in the list of mobile devices
+type MobileDevice struct {
+	ID                     string `json:"id"`
+	Name                   string `json:"name,omitempty"`
+	SerialNumber           string `json:"serialNumber,omitempty"`
+	WifiMACAddress         string `json:"wifiMacAddress,omitempty"`
+	UDID                   string `json:"udid,omitempty"`
+	PhoneNumber            string `json:"phoneNumber,omitempty"`
+	Model                  string `json:"model,omitempty"`
+	ModelIdentifier        string `json:"modelIdentifier,omitempty"`
+	Username               string `json:"username,omitempty"`
+	Type                   string `json:"type,omitempty"`
+	ManagementID           string `json:"managementId,omitempty"`
+	SoftwareUpdateDeviceID string `json:"softwareUpdateDeviceId,omitempty"`
+}
+
+// MobileDeviceDetails represents all the information associated with a mobile device
+type MobileDeviceDetails struct {
+	ID                            string                `json:"id"`
+	Name                          string                `json:"name,omitempty"`
+	EnforceName                   bool                  `json:"enforceName,omitempty"`
+	AssetTag                      string                `json:"assetTag,omitempty"`
+	LastInventoryUpdateTimestamp  string                `json:"lastInventoryUpdateTimestamp,omitempty"`
+	OSVersion                     string                `json:"osVersion,omitempty"`
+	OSBuild                       string                `json:"osBuild,omitempty"`
+	OSSupplementalBuildVersion    string                `json:"osSupplementalBuildVersion,omitempty"`
+	OSRapidSecurityResponse       string                `json:"osRapidSecurityResponse,omitempty"`
+	SoftwareUpdateDeviceID        string                `json:"softwareUpdateDeviceId,omitempty"`
+	SerialNumber                  string                `json:"serialNumber,omitempty"`
+	UDID                          string                `json:"udid,omitempty"`
+	IPAddress                     string                `json:"ipAddress,omitempty"`
+	WifiMACAddress                string                `json:"wifiMacAddress,omitempty"`
+	BluetoothMACAddress           string                `json:"bluetoothMacAddress,omitempty"`
+	Managed                       bool                  `json:"managed,omitempty"`
+	TimeZone                      string                `json:"timeZone,omitempty"`
+	InitialEntryTimestamp         string                `json:"initialEntryTimestamp,omitempty"`
+	LastEnrollmentTimestamp       string                `json:"lastEnrollmentTimestamp,omitempty"`
+	MDMProfileExpirationTimestamp string                `json:"mdmProfileExpirationTimestamp,omitempty"`
+	DeviceOwnershipLevel          string                `json:"deviceOwnershipLevel,omitempty"`
+	EnrollmentMethod              string                `json:"enrollmentMethod,omitempty"`
+	ManagementID                  string                `json:"managementId,omitempty"`
+	Site                          *Site                 `json:"site,omitempty"`
+	ExtensionAttributes           []ExtensionAttribute  `json:"extensionAttributes,omitempty"`
+	Location                      *MobileDeviceLocation `json:"location,omitempty"`
+	Type                          string                `json:"type,omitempty"`
+	IOS                           *MobileDeviceIOS      `json:"ios,omitempty"`
+}
+
+// MobileDeviceLocation holds the user and location a mobile device is assigned to
+type MobileDeviceLocation struct {
+	Username     string `json:"username,omitempty"`
+	RealName     string `json:"realName,omitempty"`
+	EmailAddress string `json:"emailAddress,omitempty"`
+	Position     string `json:"position,omitempty"`
+	PhoneNumber  string `json:"phoneNumber,omitempty"`
+	DepartmentID string `json:"departmentId,omitempty"`
+	BuildingID   string `json:"buildingId,omitempty"`
+	Room         string `json:"room,omitempty"`
+}
+
+// MobileDeviceIOS holds the information specific to iOS and iPadOS devices
+type MobileDeviceIOS struct {
+	Model                       string `json:"model,omitempty"`
+	ModelIdentifier             string `json:"modelIdentifier,omitempty"`
+	ModelNumber                 string `json:"modelNumber,omitempty"`
+	Supervised                  bool   `json:"supervised,omitempty"`
+	BatteryLevel                int    `json:"batteryLevel,omitempty"`
+	LastBackupTimestamp         string `json:"lastBackupTimestamp,omitempty"`
+	CapacityMb                  int    `json:"capacityMb,omitempty"`
+	AvailableMb                 int    `json:"availableMb,omitempty"`
+	PercentageUsed              int    `json:"percentageUsed,omitempty"`
+	Shared                      bool   `json:"shared,omitempty"`
+	DeviceLocatorServiceEnabled bool   `json:"deviceLocatorServiceEnabled,omitempty"`
+	DoNotDisturbEnabled         bool   `json:"doNotDisturbEnabled,omitempty"`
+	CloudBackupEnabled          bool   `json:"cloudBackupEnabled,omitempty"`
+	LastCloudBackupTimestamp    string `json:"lastCloudBackupTimestamp,omitempty"`
+	LocationServicesEnabled     bool   `json:"locationServicesEnabled,omitempty"`
+	ITunesStoreAccountActive    bool   `json:"itunesStoreAccountActive,omitempty"`
+}
+
+// MobileDeviceUpdate represents the fields that can be updated on a mobile device
+type MobileDeviceUpdate struct {
+	Name                       string                `json:"name,omitempty"`
+	EnforceName                *bool                 `json:"enforceName,omitempty"`
+	AssetTag                   string                `json:"assetTag,omitempty"`
+	SiteID                     string                `json:"siteId,omitempty"`
+	TimeZone                   string                `json:"timeZone,omitempty"`
+	Location                   *MobileDeviceLocation `json:"location,omitempty"`
+	UpdatedExtensionAttributes []ExtensionAttribute  `json:"updatedExtensionAttributes,omitempty"`
+}
@@ -0,0 +1,79 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package pro_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var MOBILE_DEVICES_API_ENDPOINT = "/api/v2/mobile-devices"
+
+func mobileDevicesResponseMocks(t *testing.T) *httptest.Server {
+	return authenticated(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case MOBILE_DEVICES_API_ENDPOINT:
+			fmt.Fprint(w, `{
+				"totalCount": 2,
+				"results": [
+					{"id": "1", "name": "iPad 1", "serialNumber": "DMQVG1", "udid": "0001", "model": "iPad Pro", "type": "ios"},
+					{"id": "2", "name": "iPhone 1", "serialNumber": "DMQVG2", "udid": "0002", "model": "iPhone 15", "type": "ios"}
+				]
+			}`)
+		case fmt.Sprintf("%s/1/detail", MOBILE_DEVICES_API_ENDPOINT):
+			fmt.Fprint(w, `{
+				"id": "1",
+				"name": "iPad 1",
+				"osVersion": "17.4",
+				"serialNumber": "DMQVG1",
+				"managed": true,
+				"site": {"id": "1", "name": "NYC"},
+				"location": {"username": "jane", "departmentId": "2"},
+				"ios": {"supervised": true, "capacityMb": 256000, "batteryLevel": 80}
+			}`)
+		case fmt.Sprintf("%s/1", MOBILE_DEVICES_API_ENDPOINT):
+			assert.Equal(t, "PATCH", r.Method)
+			update := &pro.MobileDeviceUpdate{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(update))
+			fmt.Fprintf(w, `{"id": "1", "name": "%s", "assetTag": "%s"}`, update.Name, update.AssetTag)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	})
+}
+
+func TestMobileDevices(t *testing.T) {
+	testServer := mobileDevicesResponseMocks(t)
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	page, err := j.MobileDevices(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, page.TotalCount)
+	assert.Equal(t, "iPhone 15", page.Results[1].Model)
+
+	devices, err := j.AllMobileDevices(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(devices))
+
+	device, err := j.MobileDeviceDetails("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "17.4", device.OSVersion)
+	assert.Equal(t, "NYC", device.Site.Name)
+	assert.Equal(t, "jane", device.Location.Username)
+	assert.True(t, device.IOS.Supervised)
+	assert.Equal(t, 256000, device.IOS.CapacityMb)
+
+	updated, err := j.UpdateMobileDevice("1", &pro.MobileDeviceUpdate{Name: "Renamed iPad", AssetTag: "A2"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Renamed iPad", updated.Name)
+	assert.Equal(t, "A2", updated.AssetTag)
+}
@@ -0,0 +1,144 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package pro
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// UploadPackage streams a local .pkg or .dmg file to the package record with the given ID. On Jamf Cloud the
+// file is stored on the cloud distribution point (JCDS). The returned digests can be saved on the package record
+// https://developer.jamf.com/jamf-pro/reference/post_v1-packages-id-upload
+func (j *Client) UploadPackage(id string, path string, opts *UploadOptions) (*PackageUpload, error) {
+	return j.UploadPackageContext(context.Background(), id, path, opts)
+}
+
+// UploadPackageContext is like UploadPackage but uses the given context for the request
+func (j *Client) UploadPackageContext(ctx context.Context, id string, path string, opts *UploadOptions) (*PackageUpload, error) {
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+
+	// the expected hash is verified up front so a corrupt file never reaches the distribution point
+	if opts.HashValue != "" {
+		hashes, err := HashPackageFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to upload package %s", path)
+		}
+		if err := hashes.Verify(opts.HashType, opts.HashValue); err != nil {
+			return nil, errors.Wrapf(err, "unable to upload package %s", path)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open package %s", path)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open package %s", path)
+	}
+	filename := filepath.Base(path)
+
+	// the multipart envelope is built ahead of time so the file can be streamed between the
+	// part header and the closing boundary with a known content length
+	var envelope bytes.Buffer
+	mw := multipart.NewWriter(&envelope)
+	if _, err := mw.CreateFormFile("file", filename); err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF upload payload for package %s", path)
+	}
+	head := bytes.Clone(envelope.Bytes())
+	envelope.Reset()
+	if err := mw.Close(); err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF upload payload for package %s", path)
+	}
+	tail := envelope.Bytes()
+
+	md5Hash, sha512Hash := md5.New(), sha512.New()
+	file := &progressReader{
+		r:        io.TeeReader(f, io.MultiWriter(md5Hash, sha512Hash)),
+		total:    info.Size(),
+		progress: opts.Progress,
+	}
+
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, resourcePath(packagesContext, id, "upload"))
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, io.MultiReader(bytes.NewReader(head), file, bytes.NewReader(tail)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF upload request for package %s", path)
+	}
+	req.ContentLength = int64(len(head)) + info.Size() + int64(len(tail))
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	res := PackageUpload{Filename: filename, Size: info.Size()}
+	if err := j.send(req, &res.HrefResponse); err != nil {
+		return nil, errors.Wrapf(err, "unable to upload package %s to package with ID: %s", path, id)
+	}
+
+	if file.sent != info.Size() {
+		return nil, fmt.Errorf("package %s changed while uploading: sent %d of %d bytes", path, file.sent, info.Size())
+	}
+	res.Hashes = PackageHashes{MD5: hexDigest(md5Hash), SHA512: hexDigest(sha512Hash)}
+
+	// the file may have been modified between the up front check and the upload
+	if opts.HashValue != "" {
+		if err := res.Hashes.Verify(opts.HashType, opts.HashValue); err != nil {
+			return nil, errors.Wrapf(err, "package %s changed while uploading to package with ID: %s", path, id)
+		}
+	}
+	return &res, nil
+}
+
+// HashPackageFile computes the MD5 and SHA-512 digests of a local package file
+func HashPackageFile(path string) (*PackageHashes, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open package %s", path)
+	}
+	defer f.Close()
+
+	md5Hash, sha512Hash := md5.New(), sha512.New()
+	if _, err := io.Copy(io.MultiWriter(md5Hash, sha512Hash), f); err != nil {
+		return nil, errors.Wrapf(err, "unable to hash package %s", path)
+	}
+	return &PackageHashes{MD5: hexDigest(md5Hash), SHA512: hexDigest(sha512Hash)}, nil
+}
+
+func hexDigest(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// progressReader reports the number of bytes read from the package file
+type progressReader struct {
+	r        io.Reader
+	sent     int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		if p.progress != nil {
+			p.progress(p.sent, p.total)
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package pro
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Hash types stored on a package record alongside the hash value
+const (
+	HashTypeMD5    = "MD5"
+	HashTypeSHA512 = "SHA_512"
+)
+
+// ErrHashMismatch is matched via errors.Is by a *HashMismatchError
+var ErrHashMismatch = errors.New("jamf: package hash mismatch")
+
+// HashMismatchError is returned when a package file does not match the hash expected by its package record
+type HashMismatchError struct {
+	HashType string
+	Expected string
+	Actual   string
+}
+
+func (e *HashMismatchError) Error() string {
+	return fmt.Sprintf("%s: expected %s %s, got %s", ErrHashMismatch, e.HashType, e.Expected, e.Actual)
+}
+
+// Is allows a HashMismatchError to be compared against ErrHashMismatch using errors.Is
+func (e *HashMismatchError) Is(target error) bool {
+	return target == ErrHashMismatch
+}
+
+// PackageHashes holds the hex encoded digests of a package file
+type PackageHashes struct {
+	MD5    string
+	SHA512 string
+}
+
+// Verify checks the digest of the given hash type (MD5 or SHA_512, i.e classic.PackageContents.HashType)
+// matches the expected value and returns a *HashMismatchError when it doesn't
+func (h PackageHashes) Verify(hashType string, expected string) error {
+	var actual string
+	switch strings.ToUpper(strings.ReplaceAll(hashType, "-", "_")) {
+	case HashTypeMD5:
+		actual = h.MD5
+	case HashTypeSHA512, "SHA512":
+		actual = h.SHA512
+	default:
+		return fmt.Errorf("unsupported package hash type: %s", hashType)
+	}
+
+	if !strings.EqualFold(actual, strings.TrimSpace(expected)) {
+		return &HashMismatchError{HashType: hashType, Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
+// ProgressFunc is called as a package file is uploaded with the number of bytes sent so far and the file size
+type ProgressFunc func(sent int64, total int64)
+
+// UploadOptions configures a package upload
+type UploadOptions struct {
+	// Progress is called each time a chunk of the package file has been sent
+	Progress ProgressFunc
+	// HashType and HashValue, when set, are verified against the local file before it is uploaded
+	// and against the digest of the bytes actually streamed once the upload is done
+	HashType  string
+	HashValue string
+}
+
+// PackageUpload holds the result of a package upload along with the digests of the uploaded file
+// which can be stored on the package record (i.e classic.PackageContents.HashType and HashValue)
+type PackageUpload struct {
+	HrefResponse
+	Filename string
+	Size     int64
+	Hashes   PackageHashes
+}
@@ -0,0 +1,168 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package pro_test
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var PACKAGES_UPLOAD_API_ENDPOINT = "/api/v1/packages/12/upload"
+
+// packageUploadMocks stands in for the package upload endpoint and records the file it receives
+func packageUploadMocks(t *testing.T, received *bytes.Buffer, uploads *int32) *httptest.Server {
+	return authenticated(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case PACKAGES_UPLOAD_API_ENDPOINT:
+			atomic.AddInt32(uploads, 1)
+			assert.Equal(t, "POST", r.Method)
+			assert.True(t, r.ContentLength > 0)
+
+			mr, err := r.MultipartReader()
+			assert.Nil(t, err)
+			part, err := mr.NextPart()
+			assert.Nil(t, err)
+			assert.Equal(t, "file", part.FormName())
+			assert.Equal(t, "Zoom-Latest.pkg", part.FileName())
+			_, err = io.Copy(received, part)
+			assert.Nil(t, err)
+			_, err = mr.NextPart()
+			assert.Equal(t, io.EOF, err)
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "12", "href": "/api/v1/packages/12"}`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	})
+}
+
+func writePackageFile(t *testing.T) (string, []byte) {
+	contents := bytes.Repeat([]byte("xar!"), 64*1024)
+	path := filepath.Join(t.TempDir(), "Zoom-Latest.pkg")
+	assert.Nil(t, os.WriteFile(path, contents, 0o600))
+	return path, contents
+}
+
+func TestUploadPackage(t *testing.T) {
+	received := &bytes.Buffer{}
+	var uploads int32
+	testServer := packageUploadMocks(t, received, &uploads)
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	path, contents := writePackageFile(t)
+	sha := sha512.Sum512(contents)
+	sum := md5.Sum(contents)
+
+	var calls int
+	var lastSent, lastTotal int64
+	res, err := j.UploadPackage("12", path, &pro.UploadOptions{
+		Progress: func(sent int64, total int64) {
+			calls++
+			assert.True(t, sent > lastSent)
+			lastSent, lastTotal = sent, total
+		},
+		HashType:  pro.HashTypeSHA512,
+		HashValue: hex.EncodeToString(sha[:]),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "12", res.ID)
+	assert.Equal(t, "Zoom-Latest.pkg", res.Filename)
+	assert.Equal(t, int64(len(contents)), res.Size)
+	assert.Equal(t, hex.EncodeToString(sha[:]), res.Hashes.SHA512)
+	assert.Equal(t, hex.EncodeToString(sum[:]), res.Hashes.MD5)
+	assert.Equal(t, contents, received.Bytes())
+
+	// the file is streamed in chunks rather than sent in a single read
+	assert.True(t, calls > 1)
+	assert.Equal(t, int64(len(contents)), lastSent)
+	assert.Equal(t, int64(len(contents)), lastTotal)
+	assert.Equal(t, int32(1), uploads)
+}
+
+func TestUploadPackage__HashMismatch(t *testing.T) {
+	received := &bytes.Buffer{}
+	var uploads int32
+	testServer := packageUploadMocks(t, received, &uploads)
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	path, _ := writePackageFile(t)
+	_, err = j.UploadPackage("12", path, &pro.UploadOptions{
+		HashType:  pro.HashTypeMD5,
+		HashValue: "d41d8cd98f00b204e9800998ecf8427e",
+	})
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, pro.ErrHashMismatch))
+	var mismatch *pro.HashMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, "d41d8cd98f00b204e9800998ecf8427e", mismatch.Expected)
+	assert.Equal(t, int32(0), uploads)
+
+	_, err = j.UploadPackage("12", filepath.Join(t.TempDir(), "missing.pkg"), nil)
+	assert.NotNil(t, err)
+}
+
+func TestUploadPackage__ModifiedWhileUploading(t *testing.T) {
+	received := &bytes.Buffer{}
+	var uploads int32
+	testServer := packageUploadMocks(t, received, &uploads)
+	defer testServer.Close()
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	path, contents := writePackageFile(t)
+	sha := sha512.Sum512(contents)
+
+	// the end of the file is overwritten once the upload has started so the up front check passes
+	var modified bool
+	_, err = j.UploadPackage("12", path, &pro.UploadOptions{
+		Progress: func(sent int64, total int64) {
+			if modified {
+				return
+			}
+			modified = true
+			f, err := os.OpenFile(path, os.O_WRONLY, 0)
+			assert.Nil(t, err)
+			_, err = f.WriteAt([]byte("!rax"), total-4)
+			assert.Nil(t, err)
+			assert.Nil(t, f.Close())
+		},
+		HashType:  pro.HashTypeSHA512,
+		HashValue: hex.EncodeToString(sha[:]),
+	})
+	assert.True(t, errors.Is(err, pro.ErrHashMismatch))
+	assert.Equal(t, int32(1), uploads)
+}
+
+func TestPackageHashesVerify(t *testing.T) {
+	path, contents := writePackageFile(t)
+	hashes, err := pro.HashPackageFile(path)
+	assert.Nil(t, err)
+
+	sha := sha512.Sum512(contents)
+	sum := md5.Sum(contents)
+	assert.Nil(t, hashes.Verify("SHA_512", hex.EncodeToString(sha[:])))
+	assert.Nil(t, hashes.Verify("sha512", hex.EncodeToString(sha[:])))
+	assert.Nil(t, hashes.Verify("MD5", hex.EncodeToString(sum[:])))
+	assert.True(t, errors.Is(hashes.Verify("MD5", "0000"), pro.ErrHashMismatch))
+	assert.NotNil(t, hashes.Verify("SHA_256", "0000"))
+}
@@ -0,0 +1,253 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultPageSize is the page size used by Jamf when none is given
+	DefaultPageSize = 100
+	// MaxPageSize is the largest page size accepted by most list endpoints
+	MaxPageSize = 2000
+)
+
+// Filter is an RSQL expression used to filter the results of a list endpoint
+// https://developer.jamf.com/jamf-pro/docs/filtering-with-rsql
+type Filter string
+
+// rsqlReserved are the characters which require a value to be quoted
+const rsqlReserved = " \"'();,=!<>~"
+
+// rsqlValue formats a value quoting it when it contains reserved characters
+func rsqlValue(v interface{}) string {
+	s := fmt.Sprint(v)
+	if s != "" && !strings.ContainsAny(s, rsqlReserved) {
+		return s
+	}
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return fmt.Sprintf(`"%s"`, s)
+}
+
+func comparison(field string, operator string, value interface{}) Filter {
+	return Filter(fmt.Sprintf("%s%s%s", field, operator, rsqlValue(value)))
+}
+
+func membership(field string, operator string, values []interface{}) Filter {
+	formatted := make([]string, len(values))
+	for i, v := range values {
+		formatted[i] = rsqlValue(v)
+	}
+	return Filter(fmt.Sprintf("%s%s(%s)", field, operator, strings.Join(formatted, ",")))
+}
+
+// Eq matches results where the field equals the value, * can be used as a wildcard
+func Eq(field string, value interface{}) Filter {
+	return comparison(field, "==", value)
+}
+
+// Ne matches results where the field does not equal the value
+func Ne(field string, value interface{}) Filter {
+	return comparison(field, "!=", value)
+}
+
+// Lt matches results where the field is less than the value
+func Lt(field string, value interface{}) Filter {
+	return comparison(field, "<", value)
+}
+
+// Le matches results where the field is less than or equal to the value
+func Le(field string, value interface{}) Filter {
+	return comparison(field, "<=", value)
+}
+
+// Gt matches results where the field is greater than the value
+func Gt(field string, value interface{}) Filter {
+	return comparison(field, ">", value)
+}
+
+// Ge matches results where the field is greater than or equal to the value
+func Ge(field string, value interface{}) Filter {
+	return comparison(field, ">=", value)
+}
+
+// In matches results where the field is one of the values
+func In(field string, values ...interface{}) Filter {
+	return membership(field, "=in=", values)
+}
+
+// Out matches results where the field is none of the values
+func Out(field string, values ...interface{}) Filter {
+	return membership(field, "=out=", values)
+}
+
+// nonEmpty drops empty filters so optional criteria can be passed without checks
+func nonEmpty(filters []Filter) []string {
+	parts := []string{}
+	for _, f := range filters {
+		if f != "" {
+			parts = append(parts, string(f))
+		}
+	}
+	return parts
+}
+
+// And matches results matching all of the filters
+func And(filters ...Filter) Filter {
+	return Filter(strings.Join(nonEmpty(filters), ";"))
+}
+
+// Or matches results matching any of the filters. Since and takes precedence
+// over or in RSQL the expression is grouped so it can be nested in And
+func Or(filters ...Filter) Filter {
+	parts := nonEmpty(filters)
+	if len(parts) > 1 {
+		return Filter(fmt.Sprintf("(%s)", strings.Join(parts, ",")))
+	}
+	return Filter(strings.Join(parts, ","))
+}
+
+// String returns the RSQL expression
+func (f Filter) String() string {
+	return string(f)
+}
+
+// Sort orders the results of a list endpoint by a field
+type Sort struct {
+	Field      string
+	Descending bool
+}
+
+// Asc sorts results by the field in ascending order
+func Asc(field string) Sort {
+	return Sort{Field: field}
+}
+
+// Desc sorts results by the field in descending order
+func Desc(field string) Sort {
+	return Sort{Field: field, Descending: true}
+}
+
+// String returns the sort criteria in the format expected by Jamf i.e general.name:asc
+func (s Sort) String() string {
+	if s.Descending {
+		return fmt.Sprintf("%s:desc", s.Field)
+	}
+	return fmt.Sprintf("%s:asc", s.Field)
+}
+
+// ListOptions are the pagination, sorting and filtering parameters accepted by list endpoints.
+// Page numbers start at 0 and Jamf defaults to a page size of 100 when PageSize is 0
+type ListOptions struct {
+	Page     int
+	PageSize int
+	Sort     []Sort
+	Filter   Filter
+}
+
+// values returns the query parameters for the options
+func (o *ListOptions) values() url.Values {
+	v := url.Values{}
+	if o == nil {
+		return v
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PageSize > 0 {
+		v.Set("page-size", strconv.Itoa(o.PageSize))
+	}
+	for _, s := range o.Sort {
+		v.Add("sort", s.String())
+	}
+	if o.Filter != "" {
+		v.Set("filter", o.Filter.String())
+	}
+	return v
+}
+
+// Page is a single page of results returned by a list endpoint
+type Page[T any] struct {
+	TotalCount int `json:"totalCount"`
+	Results    []T `json:"results"`
+}
+
+// listPage fetches a single page of results
+func listPage[T any](ctx context.Context, j *Client, path string, query url.Values) (*Page[T], error) {
+	res := Page[T]{}
+	if err := j.makeAPIrequest(ctx, "GET", path, query, nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// iterate returns an iterator over every result starting with the page given in the options. Pages are
+// only fetched once the results of the previous page have been consumed, an error stops the iteration
+func iterate[T any](ctx context.Context, j *Client, path string, opts *ListOptions, extra url.Values) iter.Seq2[T, error] {
+	o := ListOptions{PageSize: MaxPageSize}
+	if opts != nil {
+		o = *opts
+		if o.PageSize <= 0 {
+			o.PageSize = MaxPageSize
+		}
+	}
+
+	return func(yield func(T, error) bool) {
+		// endpoints may cap the page size below the one requested so the end of the results is found
+		// using the total count rather than by comparing the size of a page with the requested one
+		skipped, seen := o.Page*o.PageSize, 0
+		for page := o.Page; ; page++ {
+			query := o.values()
+			query.Set("page", strconv.Itoa(page))
+			for k, v := range extra {
+				query[k] = v
+			}
+
+			res, err := listPage[T](ctx, j, path, query)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			if len(res.Results) == 0 {
+				return
+			}
+			if page == o.Page && len(res.Results) < o.PageSize && skipped+len(res.Results) != res.TotalCount {
+				// a short first page that isn't the last one means the endpoint capped the page size
+				skipped = o.Page * len(res.Results)
+			}
+
+			for _, result := range res.Results {
+				if !yield(result, nil) {
+					return
+				}
+			}
+
+			seen += len(res.Results)
+			if skipped+seen >= res.TotalCount {
+				return
+			}
+		}
+	}
+}
+
+// listAll fetches every page of results starting with the page given in the options
+func listAll[T any](ctx context.Context, j *Client, path string, opts *ListOptions, extra url.Values) ([]T, error) {
+	results := []T{}
+	for result, err := range iterate[T](ctx, j, path, opts, extra) {
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,38 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+// This product includes software developed at Datadog (https://www.datadoghq.com/). Copyright 2020 Datadog, Inc.
+package pro_test
+
+import (
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	assert.Equal(t, `general.name==Mac*`, pro.Eq("general.name", "Mac*").String())
+	assert.Equal(t, `general.name=="Jane's Mac"`, pro.Eq("general.name", "Jane's Mac").String())
+	assert.Equal(t, `general.name=="say \"hi\""`, pro.Eq("general.name", `say "hi"`).String())
+	assert.Equal(t, `id!=1`, pro.Ne("id", 1).String())
+	assert.Equal(t, `id<1`, pro.Lt("id", 1).String())
+	assert.Equal(t, `id<=1`, pro.Le("id", 1).String())
+	assert.Equal(t, `id>1`, pro.Gt("id", 1).String())
+	assert.Equal(t, `id>=1`, pro.Ge("id", 1).String())
+	assert.Equal(t, `name==""`, pro.Eq("name", "").String())
+	assert.Equal(t, `id=in=(1,2,3)`, pro.In("id", 1, 2, 3).String())
+	assert.Equal(t, `hardware.model=out=(iMac,"Mac mini")`, pro.Out("hardware.model", "iMac", "Mac mini").String())
+
+	filter := pro.And(
+		pro.Eq("general.platform", "Mac"),
+		pro.Or(pro.Eq("general.name", "a*"), pro.Eq("general.name", "b*")),
+		"",
+	)
+	assert.Equal(t, `general.platform==Mac;(general.name==a*,general.name==b*)`, filter.String())
+	assert.Equal(t, `id==1`, pro.Or(pro.Eq("id", 1), "").String())
+	assert.Equal(t, ``, pro.And().String())
+}
+
+func TestSort(t *testing.T) {
+	assert.Equal(t, "general.name:asc", pro.Asc("general.name").String())
+	assert.Equal(t, "id:desc", pro.Desc("id").String())
+}